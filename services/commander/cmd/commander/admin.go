@@ -0,0 +1,62 @@
+package main
+
+import (
+	"RocketRankBot/services/commander/internal/bot"
+	"RocketRankBot/services/commander/internal/db"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+)
+
+const adminUsage = "Usage: commander [export-commands <channel id> [file] | import-commands <channel id> <file>]"
+
+// adminAuthor is recorded in the command history for changes made through the admin CLI
+var adminAuthor = db.CommandAuthor{TwitchUserID: db.AdminCLIAuthorID, TwitchUserLogin: "admin-cli"}
+
+// runAdminCommand runs a one-off admin operation given on the command line instead of starting the bot
+func runAdminCommand(ctx context.Context, b bot.Bot, args []string) error {
+	switch {
+	case len(args) >= 2 && len(args) <= 3 && args[0] == "export-commands":
+		export, err := b.ExportCommands(ctx, args[1])
+		if err != nil {
+			return err
+		}
+
+		out := os.Stdout
+		if len(args) == 3 {
+			out, err = os.Create(args[2])
+			if err != nil {
+				return err
+			}
+			defer out.Close()
+		}
+
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(export)
+
+	case len(args) == 3 && args[0] == "import-commands":
+		data, err := os.ReadFile(args[2])
+		if err != nil {
+			return err
+		}
+
+		export := bot.CommandExport{}
+		err = json.Unmarshal(data, &export)
+		if err != nil {
+			return err
+		}
+
+		imported, err := b.ImportCommands(ctx, args[1], &export, adminAuthor)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Imported %d commands.\n", imported)
+		return nil
+
+	default:
+		return errors.New(adminUsage)
+	}
+}
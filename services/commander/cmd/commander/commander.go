@@ -13,6 +13,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"github.com/twitchtv/twirp"
 	"net/http"
+	"os"
 	"strconv"
 )
 
@@ -35,14 +36,24 @@ func main() {
 		return
 	}
 
+	trackerGgScraper := trackerggscraper.NewTrackerGgScraperProtobufClient(cfg.Services.TrackerGgScraper, http.DefaultClient)
+	twitchAPI := twitch.NewAPI(cfg, mainDB, cacheDB)
+
+	botInstance := bot.NewBot(mainDB, cacheDB, cfg, twitchAPI, trackerGgScraper)
+
+	if len(os.Args) > 1 {
+		err = runAdminCommand(newRootContext(), botInstance, os.Args[1:])
+		if err != nil {
+			log.Fatal().Err(err).Msg("Admin command failed")
+		}
+		return
+	}
+
 	metrics.StartMetricsServer(":"+strconv.Itoa(cfg.AdminPort), func() bool {
 		return mainDB.IsConnected() && cacheDB.IsConnected()
 	})
 
-	trackerGgScraper := trackerggscraper.NewTrackerGgScraperProtobufClient(cfg.Services.TrackerGgScraper, http.DefaultClient)
-	twitchAPI := twitch.NewAPI(cfg, cacheDB)
-
-	botInstance := bot.NewBot(mainDB, cacheDB, cfg, twitchAPI, trackerGgScraper)
+	botInstance.StartRankAnnouncer(newRootContext())
 
 	serverInstance := server.NewServer(cfg, twitchAPI, mainDB, cacheDB, botInstance)
 	err = serverInstance.Start(newRootContext())
@@ -7,38 +7,56 @@ import (
 	"RocketRankBot/services/commander/internal/metrics"
 	"RocketRankBot/services/commander/internal/twitch"
 	"RocketRankBot/services/commander/rpc/trackerggscraper"
-	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog/log"
 	"github.com/twitchtv/twirp"
 	"strings"
+	"sync"
 	"time"
 )
 
 type Bot interface {
 	ExecutePossibleCommand(ctx context.Context, req *IncomingPossibleCommand)
+	StartStreamSession(ctx context.Context, channelID string, startedAt time.Time)
+	EndStreamSession(ctx context.Context, channelID string)
+	StartRankAnnouncer(ctx context.Context)
+	ExportCommands(ctx context.Context, channelID string) (*CommandExport, error)
+	ImportCommands(ctx context.Context, channelID string, export *CommandExport, importedBy db.CommandAuthor) (int, error)
 }
 
 type bot struct {
-	mainDB           db.MainDB
-	cacheDB          db.CacheDB
-	twitchAPI        twitch.API
-	baseURL          string
-	trackerGgScraper trackerggscraper.TrackerGgScraper
-	commandTimeout   time.Duration
-	commandPrefix    string
-	cacheTTLCommand  time.Duration
-	cacheTTLRank     time.Duration
-	botChannelID     string
-	configCommands   map[string]func(ctx context.Context, req *IncomingPossibleCommand)
+	mainDB             db.MainDB
+	cacheDB            db.CacheDB
+	twitchAPI          twitch.API
+	baseURL            string
+	trackerGgScraper   trackerggscraper.TrackerGgScraper
+	commandTimeout     time.Duration
+	cacheTTLCommand    time.Duration
+	cacheTTLRank       time.Duration
+	botChannelID       string
+	currentSeason      int
+	configCommands     map[string]func(ctx context.Context, req *IncomingPossibleCommand)
+	viewerCommands     map[string]func(ctx context.Context, req *IncomingPossibleCommand)
+	rankLookup         rankLookupLimits
+	announceInterval   time.Duration
+	divisionThresholds divisionThresholdCache
+}
+
+type rankLookupLimits struct {
+	userCooldown  time.Duration
+	channelLimit  int
+	channelWindow time.Duration
 }
 
 type IncomingPossibleCommand struct {
 	Command        string
 	IsModerator    bool
 	IsBroadcaster  bool
+	IsSubscriber   bool
+	IsVIP          bool
 	IsAdmin        bool
 	ChannelID      string
 	ChannelLogin   string
@@ -46,6 +64,10 @@ type IncomingPossibleCommand struct {
 	SenderLogin    string
 	MessageID      string
 	UsedPingPrefix bool
+	// Prefix is the command prefix of the channel the command was sent in
+	Prefix string
+	// Language is the language replies are sent in. In the bot's chat it is the language of the sender's channel.
+	Language string
 }
 
 func NewBot(mainDB db.MainDB, cacheDB db.CacheDB, cfg *config.CommanderConfig, ta twitch.API, tgs trackerggscraper.TrackerGgScraper) Bot {
@@ -56,18 +78,50 @@ func NewBot(mainDB db.MainDB, cacheDB db.CacheDB, cfg *config.CommanderConfig, t
 		baseURL:          cfg.BaseURL,
 		trackerGgScraper: tgs,
 		commandTimeout:   time.Second * time.Duration(cfg.CommandTimeoutSeconds),
-		commandPrefix:    cfg.CommandPrefix,
 		cacheTTLCommand:  time.Second * time.Duration(cfg.TTL.Commands),
 		cacheTTLRank:     time.Second * time.Duration(cfg.TTL.Ranks),
 		botChannelID:     cfg.Twitch.BotUserID,
+		currentSeason:    cfg.CurrentSeason,
+		rankLookup: rankLookupLimits{
+			userCooldown:  time.Second * time.Duration(cfg.RankLookup.UserCooldownSeconds),
+			channelLimit:  cfg.RankLookup.ChannelLimit,
+			channelWindow: time.Second * time.Duration(cfg.RankLookup.ChannelWindowSeconds),
+		},
+		announceInterval: time.Second * time.Duration(cfg.RankAnnouncements.PollIntervalSeconds),
 	}
 	b.configCommands = map[string]func(ctx context.Context, req *IncomingPossibleCommand){
-		"join":    b.executeCommandJoin,
-		"leave":   b.executeCommandLeave,
-		"addcom":  b.executeCommandAddcom,
-		"delcom":  b.executeCommandDelcom,
-		"editcom": b.executeCommandEditcom,
-		"listcom": b.executeCommandListcom,
+		"join":       b.executeCommandJoin,
+		"leave":      b.executeCommandLeave,
+		"addcom":     b.executeCommandAddcom,
+		"delcom":     b.executeCommandDelcom,
+		"editcom":    b.executeCommandEditcom,
+		"listcom":    b.executeCommandListcom,
+		"lookup":     b.executeCommandLookup,
+		"announce":   b.executeCommandAnnounce,
+		"alias":      b.executeCommandAlias,
+		"unalias":    b.executeCommandUnalias,
+		"showcom":    b.executeCommandShowcom,
+		"testformat": b.executeCommandTestformat,
+		"rankemote":  b.executeCommandRankemote,
+		"setlang":    b.executeCommandSetlang,
+		"prefix":     b.executeCommandPrefix,
+		"trigger":    b.executeCommandTrigger,
+		"undocom":    b.executeCommandUndocom,
+		"historycom": b.executeCommandHistorycom,
+		"pause":      b.executeCommandPause,
+		"resume":     b.executeCommandResume,
+		"apikey":     b.executeCommandApikey,
+	}
+	// Viewer commands can be used by everyone, but custom commands with the same name take precedence
+	b.viewerCommands = map[string]func(ctx context.Context, req *IncomingPossibleCommand){
+		"rank":        b.executeCommandRank,
+		"linkrl":      b.executeCommandLinkrl,
+		"unlinkrl":    b.executeCommandUnlinkrl,
+		"myrank":      b.executeCommandMyrank,
+		"enterlb":     b.executeCommandEnterlb,
+		"leavelb":     b.executeCommandLeavelb,
+		"leaderboard": b.executeCommandLeaderboard,
+		"compare":     b.executeCommandCompare,
 	}
 
 	return &b
@@ -87,8 +141,12 @@ func (b *bot) ExecutePossibleCommand(ctx context.Context, req *IncomingPossibleC
 	ctx, cancel := context.WithTimeout(ctx, b.commandTimeout)
 	defer cancel()
 
-	commandParts := strings.Split(req.Command, " ")
+	commandParts := tokenizeCommand(req.Command)
+	if len(commandParts) == 0 {
+		return
+	}
 	baseCommand := strings.ToLower(commandParts[0])
+	ctx = withLanguage(ctx, req.Language)
 
 	if cmdFunc, ok := b.configCommands[strings.ToLower(baseCommand)]; ok {
 		// Built-in commands can only be executed by mods/broadcasters using the ping prefix or in the bots chat
@@ -113,55 +171,97 @@ func (b *bot) ExecutePossibleCommand(ctx context.Context, req *IncomingPossibleC
 	}
 
 	var replyMessage string
-	var replyType db.TwitchResponseType
-	var updatedCachedCmd db.CachedCommand
+	var command db.CachedCommand
 
-	if foundCache {
-		if time.Now().Before(cachedCommand.NextExecutionAllowedTime) {
-			return
-		}
-		defer metrics.HistogramCommandResponseTime.With(prometheus.Labels{"type": "rank"}).Observe(float64(time.Now().UnixMilli() - executionStartedAt.UnixMilli()))
-		metrics.CounterExecutedCommandsRank.Inc()
-		metrics.CounterCachedCommandsRank.Inc()
+	// Entries cached before commands supported multiple accounts, aliases or format versions are reloaded from the main DB
+	if foundCache && (len(cachedCommand.RLAccounts) == 0 || len(cachedCommand.CommandName) == 0 || cachedCommand.FormatVersion == 0) {
+		foundCache = false
+	}
 
-		log.Ctx(ctx).Info().Str("channel-id", req.ChannelID).Str("channel-login", req.ChannelLogin).Str("sender-id", req.SenderID).Str("sender-login", strings.ToLower(req.SenderLogin)).Str("command", req.Command).Msg("Executing cached rank command")
-		replyMessage = b.getRankMessage(ctx, cachedCommand.RLPlatform, cachedCommand.RLUsername, cachedCommand.MessageFormat)
-		replyType = cachedCommand.TwitchResponseType
-		updatedCachedCmd = *cachedCommand
-		updatedCachedCmd.NextExecutionAllowedTime = time.Now().Add(time.Second * time.Duration(cachedCommand.CommandCooldownSeconds))
+	if foundCache {
+		command = *cachedCommand
 	} else {
-		command, foundMain, err := b.mainDB.FindCommand(ctx, req.ChannelID, baseCommand)
+		dbCommand, foundMain, err := b.mainDB.FindCommand(ctx, req.ChannelID, baseCommand)
 		if err != nil {
-			replyMessage = getMessageInternalErrorWithCtx(ctx)
 			log.Ctx(ctx).Error().Err(err).Msg("Error looking up command in DB")
 			return
 		}
 		if !foundMain {
+			if cmdFunc, ok := b.viewerCommands[baseCommand]; ok {
+				defer metrics.HistogramCommandResponseTime.With(prometheus.Labels{"type": "builtin"}).Observe(float64(time.Now().UnixMilli() - executionStartedAt.UnixMilli()))
+				metrics.CounterExecutedCommandsBuiltin.Inc()
+
+				log.Ctx(ctx).Info().Str("channel-id", req.ChannelID).Str("channel-login", req.ChannelLogin).Str("sender-id", req.SenderID).Str("sender-login", strings.ToLower(req.SenderLogin)).Str("command", req.Command).Msg("Executing builtin viewer command")
+				cmdFunc(ctx, req)
+			}
 			return
 		}
 
-		defer metrics.HistogramCommandResponseTime.With(prometheus.Labels{"type": "rank"}).Observe(float64(time.Now().UnixMilli() - executionStartedAt.UnixMilli()))
-		metrics.CounterExecutedCommandsRank.Inc()
-		log.Ctx(ctx).Info().Str("channel-id", req.ChannelID).Str("channel-login", req.ChannelLogin).Str("sender-id", req.SenderID).Str("sender-login", strings.ToLower(req.SenderLogin)).Str("command", req.Command).Msg("Executing rank command")
-
-		replyType = command.TwitchResponseType
-		replyMessage = b.getRankMessage(ctx, command.RLPlatform, command.RLUsername, command.MessageFormat)
-		updatedCachedCmd = db.CachedCommand{
-			CommandCooldownSeconds:   command.CommandCooldownSeconds,
-			NextExecutionAllowedTime: time.Now().Add(time.Second * time.Duration(command.CommandCooldownSeconds)),
-			MessageFormat:            command.MessageFormat,
-			TwitchResponseType:       command.TwitchResponseType,
-			RLPlatform:               command.RLPlatform,
-			RLUsername:               command.RLUsername,
+		command = db.CachedCommand{
+			CommandName:            dbCommand.CommandName,
+			CommandCooldownSeconds: dbCommand.CommandCooldownSeconds,
+			UserCooldownSeconds:    dbCommand.UserCooldownSeconds,
+			CooldownFeedback:       dbCommand.CooldownFeedback,
+			MessageFormat:          dbCommand.MessageFormat,
+			FormatVersion:          dbCommand.FormatVersion,
+			TwitchResponseType:     dbCommand.TwitchResponseType,
+			CommandType:            dbCommand.CommandType,
+			PermissionLevel:        dbCommand.PermissionLevel,
+			RLAccounts:             dbCommand.RLAccounts,
+			Disabled:               !dbCommand.Enabled,
+		}
+		command.RankEmotes, err = b.mainDB.FindRankEmotes(ctx, req.ChannelID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error looking up rank emotes in DB")
+		}
+		channel, foundChannel, err := b.mainDB.FindUser(ctx, req.ChannelID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error looking up channel in DB")
+		}
+		if foundChannel {
+			command.Paused = channel.CommandsPaused
+		}
+		err = b.cacheDB.SetCachedCommand(ctx, req.ChannelID, baseCommand, &command, b.cacheTTLCommand)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error updating command cache")
 		}
 	}
 
-	err = b.cacheDB.SetCachedCommand(ctx, req.ChannelID, baseCommand, &updatedCachedCmd, b.cacheTTLCommand)
-	if err != nil {
-		log.Ctx(ctx).Error().Err(err).Msg("Error updating command cache")
+	// Disabled and paused commands still shadow viewer commands of the same name
+	if command.Disabled || command.Paused {
+		return
+	}
+	if !hasCommandPermission(req, command.PermissionLevel) {
+		return
+	}
+	// Aliases share the cooldown of the command they point to
+	if !b.acquireCommandCooldown(ctx, req, command.CommandName, &command) {
+		return
+	}
+
+	defer metrics.HistogramCommandResponseTime.With(prometheus.Labels{"type": "rank"}).Observe(float64(time.Now().UnixMilli() - executionStartedAt.UnixMilli()))
+	metrics.CounterExecutedCommandsRank.Inc()
+	if foundCache {
+		metrics.CounterCachedCommandsRank.Inc()
+	}
+	log.Ctx(ctx).Info().Str("channel-id", req.ChannelID).Str("channel-login", req.ChannelLogin).Str("sender-id", req.SenderID).Str("sender-login", strings.ToLower(req.SenderLogin)).Str("command", req.Command).Bool("cached", foundCache).Msg("Executing rank command")
+
+	replyType := command.TwitchResponseType
+	isCompareCommand := command.CommandType == db.CommandTypeCompare
+	if isCompareCommand {
+		replyMessage = b.getCompareCommandMessage(ctx, req, baseCommand, command.RLAccounts[0], commandParts[1:])
+	} else {
+		invocation := newInvocation(ctx, req)
+		invocation.RankEmotes = command.RankEmotes
+		replyMessage = b.getRankMessage(ctx, req.ChannelID, invocation, command.RLAccounts, command.MessageFormat, command.FormatVersion)
 	}
 
-	if replyType == db.TwitchResponseTypeMention {
+	if len(replyMessage) == 0 {
+		return
+	}
+
+	// The arguments of compare commands name a player, not a chatter to mention
+	if replyType == db.TwitchResponseTypeMention && !isCompareCommand {
 		if len(commandParts) > 1 {
 			if strings.HasPrefix("@", commandParts[1]) {
 				replyMessage = commandParts[1] + " " + replyMessage
@@ -178,58 +278,287 @@ func (b *bot) ExecutePossibleCommand(ctx context.Context, req *IncomingPossibleC
 	}
 }
 
-func (b *bot) getRankMessage(ctx context.Context, platform db.RLPlatform, identifier string, format string) string {
+// getRankMessage renders format for the given accounts. Session tokens are only evaluated against the stream session
+// of channelID if it is not empty.
+func (b *bot) getRankMessage(ctx context.Context, channelID string, invocation *formatter.Invocation, accounts []db.RLAccount, format string, formatVersion db.FormatVersion) string {
+	return b.renderRankMessage(ctx, channelID, invocation, accounts, format, formatVersion, false)
+}
+
+// getRankMessagePreview renders format like getRankMessage, but neither records the fetched ranks in the rank history
+// and peaks nor updates the stream session
+func (b *bot) getRankMessagePreview(ctx context.Context, channelID string, invocation *formatter.Invocation, accounts []db.RLAccount, format string, formatVersion db.FormatVersion) string {
+	return b.renderRankMessage(ctx, channelID, invocation, accounts, format, formatVersion, true)
+}
+
+func (b *bot) renderRankMessage(ctx context.Context, channelID string, invocation *formatter.Invocation, accounts []db.RLAccount, format string, formatVersion db.FormatVersion, preview bool) string {
+	rankResults, errorMessage, ok := b.fetchAllRanks(ctx, accounts, !preview)
+	if !ok {
+		return errorMessage
+	}
+
+	isTemplate := formatVersion == db.FormatVersionTemplate
+
+	usesSessionTokens := formatter.UsesSessionTokens(format)
+	usesPeakTokens := formatter.UsesPeakTokens(format)
+	if isTemplate {
+		usesSessionTokens = formatter.TemplateUsesSession(format)
+		usesPeakTokens = formatter.TemplateUsesPeaks(format)
+	}
+
+	var sessionStats []map[trackerggscraper.RankPlaylist]formatter.SessionStats
+	if len(channelID) > 0 && usesSessionTokens {
+		if preview {
+			sessionStats = b.peekSessionStats(ctx, channelID, accounts, rankResults)
+		} else {
+			sessionStats = b.getSessionStats(ctx, channelID, accounts, rankResults)
+		}
+	}
+
+	accountData := make([]formatter.AccountData, len(accounts))
+	for i, account := range accounts {
+		accountData[i].Ranks = rankResults[i]
+		if sessionStats != nil {
+			accountData[i].Session = sessionStats[i]
+		}
+		if usesPeakTokens {
+			accountData[i].Peaks = b.getPeakStats(ctx, account)
+		}
+	}
+
+	if isTemplate {
+		return b.formatTemplate(ctx, accountData, invocation, format)
+	}
+
+	return formatter.FormatRankString(accountData, invocation, format)
+}
+
+// formatTemplate renders a version 2 format, replacing the message with an error message if rendering fails
+func (b *bot) formatTemplate(ctx context.Context, accountData []formatter.AccountData, invocation *formatter.Invocation, format string) string {
+	var thresholds formatter.DivisionThresholds
+	if formatter.TemplateUsesDivisionThresholds(format) {
+		thresholds = b.getDivisionThresholds(ctx)
+	}
+	message, err := formatter.FormatTemplate(accountData, invocation, thresholds, format)
+	if err != nil {
+		// The error is a short *formatter.TemplateError, the text/template error it wraps is only logged
+		log.Ctx(ctx).Warn().Err(errors.Unwrap(err)).Msg("Could not render template format")
+		return localize(ctx, messageFormatError) + err.Error()
+	}
+	return message
+}
+
+// validateFormat checks a format in the syntax of its format version
+func validateFormat(format string, formatVersion db.FormatVersion, accountCount int) error {
+	if formatVersion == db.FormatVersionTemplate {
+		return formatter.ValidateTemplate(format, accountCount)
+	}
+	return formatter.ValidateFormat(format, accountCount)
+}
+
+// fetchAllRanks fetches the ranks of all accounts concurrently. If any of them fails, the message describing the
+// failure is returned instead. Freshly scraped ranks are only added to the rank history and peaks if record is set.
+func (b *bot) fetchAllRanks(ctx context.Context, accounts []db.RLAccount, record bool) ([]*trackerggscraper.PlayerCurrentRanksRes, string, bool) {
+	rankResults := make([]*trackerggscraper.PlayerCurrentRanksRes, len(accounts))
+	rankErrors := make([]error, len(accounts))
+
+	var wg sync.WaitGroup
+	for i, account := range accounts {
+		wg.Go(func() {
+			rankResults[i], rankErrors[i] = b.fetchRanksWithHistory(ctx, account, record)
+		})
+	}
+	wg.Wait()
+
+	for i, err := range rankErrors {
+		if err != nil {
+			return nil, b.getRankErrorMessage(ctx, accounts[i], err), false
+		}
+	}
+
+	return rankResults, "", true
+}
+
+func (b *bot) fetchRanks(ctx context.Context, account db.RLAccount) (*trackerggscraper.PlayerCurrentRanksRes, error) {
+	return b.fetchRanksWithHistory(ctx, account, true)
+}
 
-	rankRes, wasCached, err := b.cacheDB.FindCachedRank(ctx, platform, identifier)
+func (b *bot) fetchRanksWithHistory(ctx context.Context, account db.RLAccount, record bool) (*trackerggscraper.PlayerCurrentRanksRes, error) {
+	rankRes, wasCached, err := b.cacheDB.FindCachedRank(ctx, account.Platform, account.Username)
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("Error looking up cached rank")
 	}
 	if wasCached {
 		metrics.CounterCachedRequestsRank.Inc()
+		return rankRes, nil
+	}
+
+	rankReq := trackerggscraper.PlayerCurrentRanksReq{
+		Platform:   platformDBToProtoMapping[account.Platform],
+		Identifier: account.Username,
+	}
+	rankRes, err = b.trackerGgScraper.PlayerCurrentRanks(ctx, &rankReq)
+	if err != nil {
+		return nil, err
+	}
+
+	err = b.cacheDB.SetCachedRank(ctx, account.Platform, account.Username, rankRes, b.cacheTTLRank)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error updating rank cache")
+	}
+	if !record {
+		return rankRes, nil
 	}
 
-	if !wasCached {
-		rankReq := trackerggscraper.PlayerCurrentRanksReq{
-			Platform:   platformDBToProtoMapping[platform],
-			Identifier: identifier,
+	observedAt := time.Now()
+	observations := make([]db.RankObservation, 0, len(rankRes.Ranks))
+	peaks := make([]db.RankPeak, 0, len(rankRes.Ranks))
+	for _, ranking := range rankRes.Ranks {
+		observations = append(observations, db.RankObservation{
+			Account:    account,
+			Playlist:   int32(ranking.Playlist),
+			MMR:        int(ranking.Mmr),
+			Rank:       int(ranking.Rank),
+			Division:   int(ranking.Division),
+			ObservedAt: observedAt,
+		})
+		peaks = append(peaks, db.RankPeak{
+			Account:      account,
+			Playlist:     int32(ranking.Playlist),
+			Season:       b.currentSeason,
+			PeakMMR:      int(ranking.Mmr),
+			PeakRank:     int(ranking.Rank),
+			PeakDivision: int(ranking.Division),
+		})
+	}
+	err = b.mainDB.AddRankObservations(ctx, observations)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error adding rank observations to history")
+	}
+	err = b.mainDB.UpdateRankPeaks(ctx, peaks)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error updating rank peaks")
+	}
+
+	return rankRes, nil
+}
+
+func (b *bot) getPeakStats(ctx context.Context, account db.RLAccount) map[trackerggscraper.RankPlaylist]formatter.PeakStats {
+	peaks, err := b.mainDB.FindRankPeaks(ctx, account)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error looking up rank peaks")
+		return nil
+	}
+
+	peakStats := make(map[trackerggscraper.RankPlaylist]formatter.PeakStats)
+	for _, peak := range peaks {
+		playlist := trackerggscraper.RankPlaylist(peak.Playlist)
+		stats := peakStats[playlist]
+
+		if peak.Season == b.currentSeason {
+			stats.SeasonMMR = peak.PeakMMR
+			stats.SeasonRank = peak.PeakRank
+			stats.SeasonDivision = peak.PeakDivision
 		}
-		rankRes, err = b.trackerGgScraper.PlayerCurrentRanks(ctx, &rankReq)
-		if err != nil {
-			var twirpErr twirp.Error
-			if errors.As(err, &twirpErr) {
-				if twirpErr.Code() == twirp.ResourceExhausted {
-					log.Ctx(ctx).Info().Err(err).Msg("Rank service is rate limited")
-					return messageRateLimited
-				} else if twirpErr.Code() == twirp.NotFound {
-					notFoundStruct := struct {
-						PlayerName     string
-						PlayerPlatform string
-					}{
-						PlayerName:     identifier,
-						PlayerPlatform: string(platform),
-					}
-					var notFoundMessageBuf bytes.Buffer
-					err = templateMessageNotFound.Execute(&notFoundMessageBuf, notFoundStruct)
-					if err != nil {
-						log.Ctx(ctx).Error().Err(err).Msg("Error executing not found template")
-						return getMessageInternalErrorWithCtx(ctx)
-					}
-					return notFoundMessageBuf.String()
-				}
-			}
-			log.Ctx(ctx).Error().Err(err).Msg("Error getting ranks from scraping service")
-			return getMessageInternalErrorWithCtx(ctx)
+		stats.AllTimeMMR = max(stats.AllTimeMMR, peak.PeakMMR)
+		if peak.PeakRank > stats.AllTimeRank || (peak.PeakRank == stats.AllTimeRank && peak.PeakDivision > stats.AllTimeDivision) {
+			stats.AllTimeRank = peak.PeakRank
+			stats.AllTimeDivision = peak.PeakDivision
+		}
+
+		peakStats[playlist] = stats
+	}
+
+	return peakStats
+}
+
+func (b *bot) getRankErrorMessage(ctx context.Context, account db.RLAccount, err error) string {
+	var twirpErr twirp.Error
+	if errors.As(err, &twirpErr) {
+		if twirpErr.Code() == twirp.ResourceExhausted {
+			log.Ctx(ctx).Info().Err(err).Msg("Rank service is rate limited")
+			return localize(ctx, messageRateLimited)
+		} else if twirpErr.Code() == twirp.NotFound {
+			return fmt.Sprintf(localize(ctx, messagePlayerNotFound), account.Username, account.Platform)
+		}
+	}
+	log.Ctx(ctx).Error().Err(err).Msg("Error getting ranks from scraping service")
+	return getMessageInternalErrorWithCtx(ctx)
+}
+
+// sendTwitchMessageList sends prefix followed by the items joined with separator, split into as many messages as
+// needed to stay within the Twitch message length limit
+func (b *bot) sendTwitchMessageList(ctx context.Context, channelID string, prefix string, items []string, separator string, asReplyTo *string) {
+	resMsg := prefix
+
+	firstItemInList := true
+	for _, item := range items {
+		if len(resMsg)+len(separator)+len(item) > twitchMaxMessageLength {
+			b.sendTwitchMessage(ctx, channelID, resMsg, asReplyTo)
+			resMsg = ""
+			firstItemInList = true
 		}
+		if firstItemInList {
+			resMsg += item
+			firstItemInList = false
+		} else {
+			resMsg += separator + item
+		}
+	}
+	b.sendTwitchMessage(ctx, channelID, resMsg, asReplyTo)
+}
+
+// newInvocation collects the data of a chat message that formats can refer to
+func newInvocation(ctx context.Context, req *IncomingPossibleCommand) *formatter.Invocation {
+	return &formatter.Invocation{
+		Args:     tokenizeCommand(req.Command)[1:],
+		Sender:   req.SenderLogin,
+		Channel:  req.ChannelLogin,
+		Language: contextLanguage(ctx),
+	}
+}
+
+// commandAuthor identifies the sender of a command for the command history
+func commandAuthor(req *IncomingPossibleCommand) db.CommandAuthor {
+	return db.CommandAuthor{TwitchUserID: req.SenderID, TwitchUserLogin: req.SenderLogin}
+}
 
-		err = b.cacheDB.SetCachedRank(ctx, platform, identifier, rankRes, b.cacheTTLRank)
+// invalidateCachedCommand removes a command from the cache under its own name and all of its aliases
+func (b *bot) invalidateCachedCommand(ctx context.Context, cmd *db.BotCommand) {
+	for _, name := range append([]string{cmd.CommandName}, cmd.Aliases...) {
+		err := b.cacheDB.InvalidateCachedCommand(ctx, cmd.TwitchUserID, name)
 		if err != nil {
-			log.Ctx(ctx).Error().Err(err).Msg("Error updating rank cache")
+			log.Ctx(ctx).Warn().Err(err).Msg("Could not invalidate cached command")
 		}
 	}
+}
+
+// invalidateChannelCommands removes all commands of a channel from the cache, which is needed when channel settings that
+// are cached with every command change
+func (b *bot) invalidateChannelCommands(ctx context.Context, channelID string) {
+	commands, err := b.mainDB.FindUserCommands(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Could not query db for commands to invalidate")
+		return
+	}
+	for _, cmd := range *commands {
+		b.invalidateCachedCommand(ctx, &cmd)
+	}
+}
+
+// invalidateChannelSettings removes the prefix, keyword triggers and language of a channel from the cache
+func (b *bot) invalidateChannelSettings(ctx context.Context, channelID string) {
+	err := b.cacheDB.InvalidateCachedChannelSettings(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Could not invalidate cached channel settings")
+	}
+}
 
-	return formatter.FormatRankString(rankRes, format)
+// sendLocalizedMessage sends a message of a built-in command in the language of the context
+func (b *bot) sendLocalizedMessage(ctx context.Context, channelID string, key messageKey, asReplyTo *string) {
+	b.sendTwitchMessage(ctx, channelID, localize(ctx, key), asReplyTo)
 }
 
+// sendTwitchMessage sends a message to a channel as it is
 func (b *bot) sendTwitchMessage(ctx context.Context, channelID string, message string, asReplyTo *string) {
 	err := b.twitchAPI.SendChatMessage(ctx, channelID, message, asReplyTo)
 	if err != nil {
@@ -237,3 +566,24 @@ func (b *bot) sendTwitchMessage(ctx context.Context, channelID string, message s
 		return
 	}
 }
+
+// hasCommandPermission checks whether the sender may use a command with the given permission level. Every level also
+// admits the roles above it, and commands without a level are usable by everyone.
+func hasCommandPermission(req *IncomingPossibleCommand, level db.PermissionLevel) bool {
+	if req.IsBroadcaster || req.IsAdmin {
+		return true
+	}
+
+	switch level {
+	case db.PermissionLevelBroadcaster:
+		return false
+	case db.PermissionLevelModerator:
+		return req.IsModerator
+	case db.PermissionLevelVIP:
+		return req.IsModerator || req.IsVIP
+	case db.PermissionLevelSubscriber:
+		return req.IsModerator || req.IsVIP || req.IsSubscriber
+	default:
+		return true
+	}
+}
@@ -8,12 +8,16 @@ import (
 )
 
 const (
-	messageAddcomUsage        = "Unexpected Arguments. Usage: !addcom [command] [platform] [username]"
-	messageCommandNameTaken   = "This command name is already in use."
-	messageCommandAdded       = "Command successfully added!"
-	addcomDefaultFormat       = "Ranked 1v1: $(1.r) Div $(1.d) ($(1.m)) | Ranked 2v2: $(2.r) Div $(2.d) ($(2.m)) | Ranked 3v3: $(3.r) Div $(3.d) ($(3.m))"
-	addcomDefaultCooldown     = 10
-	addcomDefaultResponseType = db.TwitchResponseTypeMessage
+	messageAddcomUsage         messageKey = "addcomUsage"
+	messageCommandNameTaken    messageKey = "commandNameTaken"
+	messageCommandAdded        messageKey = "commandAdded"
+	addcomDefaultFormat                   = "Ranked 1v1: $(1.r) Div $(1.d) ($(1.m)) | Ranked 2v2: $(2.r) Div $(2.d) ($(2.m)) | Ranked 3v3: $(3.r) Div $(3.d) ($(3.m))"
+	addcomDefaultCooldown                 = 10
+	addcomDefaultResponseType             = db.TwitchResponseTypeMessage
+	addcomDefaultCommandType              = db.CommandTypeRank
+	addcomDefaultPermission               = db.PermissionLevelEveryone
+	addcomDefaultFeedback                 = db.CooldownFeedbackSilent
+	addcomDefaultFormatVersion            = db.FormatVersionTokens
 )
 
 func (b *bot) executeCommandAddcom(ctx context.Context, req *IncomingPossibleCommand) {
@@ -25,9 +29,9 @@ func (b *bot) executeCommandAddcom(ctx context.Context, req *IncomingPossibleCom
 		channelID = req.ChannelID
 	}
 
-	args := strings.Split(req.Command, " ")
+	args := tokenizeCommand(req.Command)
 	if len(args) < 4 {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageAddcomUsage, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageAddcomUsage, &req.MessageID)
 		return
 	}
 
@@ -38,14 +42,14 @@ func (b *bot) executeCommandAddcom(ctx context.Context, req *IncomingPossibleCom
 		return
 	}
 	if !found {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
 		return
 	}
 
-	commandName := strings.TrimPrefix(strings.ToLower(args[1]), b.commandPrefix)
+	commandName := strings.TrimPrefix(strings.ToLower(args[1]), req.Prefix)
 
 	if _, ok := b.configCommands[commandName]; ok {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageCommandNameTaken, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandNameTaken, &req.MessageID)
 		return
 	}
 
@@ -56,13 +60,13 @@ func (b *bot) executeCommandAddcom(ctx context.Context, req *IncomingPossibleCom
 		return
 	}
 	if found {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageCommandNameTaken, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandNameTaken, &req.MessageID)
 		return
 	}
 
 	platform := strings.ToLower(args[2])
 	if _, ok := db.AllPlatforms[platform]; !ok {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
 		return
 	}
 
@@ -72,18 +76,22 @@ func (b *bot) executeCommandAddcom(ctx context.Context, req *IncomingPossibleCom
 		CommandName:            commandName,
 		CommandCooldownSeconds: addcomDefaultCooldown,
 		MessageFormat:          addcomDefaultFormat,
+		FormatVersion:          addcomDefaultFormatVersion,
 		TwitchUserID:           channelID,
 		TwitchResponseType:     addcomDefaultResponseType,
-		RLPlatform:             db.RLPlatform(platform),
-		RLUsername:             username,
+		CommandType:            addcomDefaultCommandType,
+		PermissionLevel:        addcomDefaultPermission,
+		CooldownFeedback:       addcomDefaultFeedback,
+		RLAccounts:             []db.RLAccount{{Platform: db.RLPlatform(platform), Username: username}},
+		Enabled:                true,
 	}
 
-	err = b.mainDB.AddCommand(ctx, &cmd)
+	err = b.mainDB.AddCommand(ctx, &cmd, commandAuthor(req))
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("Could not add command to db")
 		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
 		return
 	}
 
-	b.sendTwitchMessage(ctx, req.ChannelID, messageCommandAdded, &req.MessageID)
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandAdded, &req.MessageID)
 }
@@ -0,0 +1,118 @@
+package bot
+
+import (
+	"context"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+const (
+	messageAliasUsage   messageKey = "aliasUsage"
+	messageUnaliasUsage messageKey = "unaliasUsage"
+	messageAliasAdded   messageKey = "aliasAdded"
+	messageAliasDeleted messageKey = "aliasDeleted"
+	messageNotAnAlias   messageKey = "notAnAlias"
+)
+
+func (b *bot) executeCommandAlias(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) != 3 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageAliasUsage, &req.MessageID)
+		return
+	}
+
+	aliasName := strings.TrimPrefix(strings.ToLower(args[1]), req.Prefix)
+	commandName := strings.TrimPrefix(strings.ToLower(args[2]), req.Prefix)
+
+	if _, ok := b.configCommands[aliasName]; ok {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandNameTaken, &req.MessageID)
+		return
+	}
+
+	_, found, err := b.mainDB.FindCommand(ctx, channelID, aliasName)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for command")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandNameTaken, &req.MessageID)
+		return
+	}
+
+	dbCmd, found, err := b.mainDB.FindCommand(ctx, channelID, commandName)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for command")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandDoesNotExist, &req.MessageID)
+		return
+	}
+
+	// Aliases always point to the command itself, so an alias of an alias targets the original command
+	err = b.mainDB.AddCommandAlias(ctx, channelID, aliasName, dbCmd.CommandName)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not add alias to db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageAliasAdded, &req.MessageID)
+}
+
+func (b *bot) executeCommandUnalias(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) != 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageUnaliasUsage, &req.MessageID)
+		return
+	}
+
+	aliasName := strings.TrimPrefix(strings.ToLower(args[1]), req.Prefix)
+
+	dbCmd, found, err := b.mainDB.FindCommand(ctx, channelID, aliasName)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for command")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandDoesNotExist, &req.MessageID)
+		return
+	}
+	if dbCmd.CommandName == aliasName {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageNotAnAlias, &req.MessageID)
+		return
+	}
+
+	err = b.mainDB.DeleteCommandAlias(ctx, channelID, aliasName)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not delete alias from db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	err = b.cacheDB.InvalidateCachedCommand(ctx, channelID, aliasName)
+	if err != nil {
+		log.Ctx(ctx).Warn().Err(err).Msg("Could not invalidate cached command")
+	}
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageAliasDeleted, &req.MessageID)
+}
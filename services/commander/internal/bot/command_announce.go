@@ -0,0 +1,68 @@
+package bot
+
+import (
+	"context"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+const (
+	messageAnnounceUsage        messageKey = "announceUsage"
+	messageAnnounceEnabled      messageKey = "announceEnabled"
+	messageAnnounceDisabled     messageKey = "announceDisabled"
+	messageAnnounceFormatUpdate messageKey = "announceFormatUpdate"
+)
+
+func (b *bot) executeCommandAnnounce(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) < 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageAnnounceUsage, &req.MessageID)
+		return
+	}
+
+	dbUser, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		return
+	}
+
+	var replyMessage messageKey
+
+	switch strings.ToLower(args[1]) {
+	case "on":
+		dbUser.AnnounceEnabled = true
+		replyMessage = messageAnnounceEnabled
+	case "off":
+		dbUser.AnnounceEnabled = false
+		replyMessage = messageAnnounceDisabled
+	case "format":
+		// An empty format resets the channel to the default announcement format
+		dbUser.AnnounceFormat = commandRest(req.Command, 2)
+		replyMessage = messageAnnounceFormatUpdate
+	default:
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageAnnounceUsage, &req.MessageID)
+		return
+	}
+
+	err = b.mainDB.UpdateUserAnnounceSettings(ctx, channelID, dbUser.AnnounceEnabled, dbUser.AnnounceFormat)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not update announce settings in db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+}
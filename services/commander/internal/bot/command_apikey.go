@@ -0,0 +1,59 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/util"
+	"context"
+	"fmt"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	messageAPIKeyWhisper messageKey = "apiKeyWhisper"
+	messageAPIKeySent    messageKey = "apiKeySent"
+	messageAPIKeyFailed  messageKey = "apiKeyFailed"
+)
+
+// executeCommandApikey creates a new API key for the HTTP API of a channel and whispers it to the sender, so it never
+// shows up in chat
+func (b *bot) executeCommandApikey(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+	var channelLogin string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+		channelLogin = req.SenderLogin
+	} else {
+		channelID = req.ChannelID
+		channelLogin = req.ChannelLogin
+	}
+
+	_, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		return
+	}
+
+	key := util.NewAPIKey()
+
+	// The key is whispered before it is stored, so a failed whisper does not invalidate the previous key
+	err = b.twitchAPI.SendWhisper(ctx, req.SenderID, fmt.Sprintf(localize(ctx, messageAPIKeyWhisper), channelLogin, key))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error sending api key whisper")
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageAPIKeyFailed, &req.MessageID)
+		return
+	}
+
+	err = b.mainDB.UpsertAPIKey(ctx, channelID, util.HashAPIKey(key), commandAuthor(req))
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not store api key in db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageAPIKeySent, &req.MessageID)
+}
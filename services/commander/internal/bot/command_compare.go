@@ -0,0 +1,82 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"RocketRankBot/services/commander/internal/formatter"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	messageCompareUsage        messageKey = "compareUsage"
+	messageCompareCommandUsage messageKey = "compareCommandUsage"
+)
+
+func (b *bot) executeCommandCompare(ctx context.Context, req *IncomingPossibleCommand) {
+	args := tokenizeCommand(req.Command)[1:]
+
+	vsIndex := slices.IndexFunc(args, func(arg string) bool {
+		return strings.ToLower(arg) == "vs"
+	})
+	if vsIndex < 2 || len(args)-vsIndex < 3 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCompareUsage, &req.MessageID)
+		return
+	}
+
+	first, ok := parseAccountArgs(args[:vsIndex])
+	if !ok {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
+		return
+	}
+	second, ok := parseAccountArgs(args[vsIndex+1:])
+	if !ok {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
+		return
+	}
+
+	if !b.acquireRankLookupLimits(ctx, req) {
+		return
+	}
+
+	b.sendTwitchMessage(ctx, req.ChannelID, b.getCompareMessage(ctx, first, second), &req.MessageID)
+}
+
+// getCompareCommandMessage compares the account of a compare command with the account given in the chat arguments.
+// An empty message is returned if the sender is rate limited.
+func (b *bot) getCompareCommandMessage(ctx context.Context, req *IncomingPossibleCommand, commandName string, account db.RLAccount, args []string) string {
+	if len(args) < 2 {
+		return fmt.Sprintf(localize(ctx, messageCompareCommandUsage), req.Prefix, commandName)
+	}
+
+	other, ok := parseAccountArgs(args)
+	if !ok {
+		return localize(ctx, messageInvalidPlatform)
+	}
+
+	if !b.acquireRankLookupLimits(ctx, req) {
+		return ""
+	}
+
+	return b.getCompareMessage(ctx, account, other)
+}
+
+func (b *bot) getCompareMessage(ctx context.Context, first db.RLAccount, second db.RLAccount) string {
+	rankResults, errorMessage, ok := b.fetchAllRanks(ctx, []db.RLAccount{first, second}, true)
+	if !ok {
+		return errorMessage
+	}
+
+	return formatter.FormatComparison(rankResults[0], rankResults[1])
+}
+
+// parseAccountArgs parses the platform and the username, which may contain spaces, from chat arguments
+func parseAccountArgs(args []string) (db.RLAccount, bool) {
+	platform := strings.ToLower(args[0])
+	if _, ok := db.AllPlatforms[platform]; !ok {
+		return db.RLAccount{}, false
+	}
+
+	return db.RLAccount{Platform: db.RLPlatform(platform), Username: strings.Join(args[1:], " ")}, true
+}
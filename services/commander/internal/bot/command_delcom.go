@@ -7,8 +7,9 @@ import (
 )
 
 const (
-	messageDelcomUsage    = "Unexpected Arguments. Usage: !delcom [command]"
-	messageCommandDeleted = "Command successfully deleted."
+	messageDelcomUsage    messageKey = "delcomUsage"
+	messageCommandDeleted messageKey = "commandDeleted"
+	messageDelcomAlias    messageKey = "delcomAlias"
 )
 
 func (b *bot) executeCommandDelcom(ctx context.Context, req *IncomingPossibleCommand) {
@@ -20,15 +21,15 @@ func (b *bot) executeCommandDelcom(ctx context.Context, req *IncomingPossibleCom
 		channelID = req.ChannelID
 	}
 
-	args := strings.Split(req.Command, " ")
+	args := tokenizeCommand(req.Command)
 	if len(args) != 2 {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageDelcomUsage, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageDelcomUsage, &req.MessageID)
 		return
 	}
 
-	commandName := strings.TrimPrefix(strings.ToLower(args[1]), b.commandPrefix)
+	commandName := strings.TrimPrefix(strings.ToLower(args[1]), req.Prefix)
 
-	_, found, err := b.mainDB.FindCommand(ctx, channelID, commandName)
+	dbCmd, found, err := b.mainDB.FindCommand(ctx, channelID, commandName)
 	if err != nil {
 		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
 		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for command")
@@ -36,21 +37,24 @@ func (b *bot) executeCommandDelcom(ctx context.Context, req *IncomingPossibleCom
 	}
 
 	if !found {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageCommandDoesNotExist, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandDoesNotExist, &req.MessageID)
+		return
+	}
+	if dbCmd.CommandName != commandName {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageDelcomAlias, &req.MessageID)
 		return
 	}
 
-	err = b.mainDB.DeleteCommand(ctx, channelID, commandName)
+	err = b.mainDB.DeleteCommand(ctx, channelID, commandName, commandAuthor(req))
 	if err != nil {
 		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
 		log.Ctx(ctx).Error().Err(err).Msg("Could not delete command from db")
 		return
 	}
 
-	err = b.cacheDB.InvalidateCachedCommand(ctx, channelID, commandName)
-	if err != nil {
-		log.Ctx(ctx).Warn().Err(err).Msg("Could not invalidate cached command")
-	}
+	// Aliases and keyword triggers are removed together with the command by the database
+	b.invalidateCachedCommand(ctx, dbCmd)
+	b.invalidateChannelSettings(ctx, channelID)
 
-	b.sendTwitchMessage(ctx, req.ChannelID, messageCommandDeleted, &req.MessageID)
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandDeleted, &req.MessageID)
 }
@@ -9,15 +9,26 @@ import (
 )
 
 const (
-	messageEditcomUsage          = "Unexpected Arguments. Usage: !editcom [command] [account/action/cooldown/format] [values...]"
-	messageEditcomAccountUsage   = "Unexpected Arguments. Usage: !editcom [command] account [platform] [username]"
-	messageEditcomActionUsage    = "Unexpected Arguments. Usage: !editcom [command] action [reply action]"
-	messageEditcomCooldownUsage  = "Unexpected Arguments. Usage: !editcom [command] cooldown [seconds]"
-	messageCommandUpdated        = "Updated command successfully!"
-	messageAddcomInvalidProperty = "Invalid property. Available properties: account, action, cooldown, format"
-	messageInvalidReplyAction    = "Invalid reply action. Available actions: message, reply, mention"
-	messageMinCooldown           = "The minimum cooldown for commands is 5 seconds."
-	commandMinCooldown           = 5
+	messageEditcomUsage              messageKey = "editcomUsage"
+	messageEditcomAccountUsage       messageKey = "editcomAccountUsage"
+	messageEditcomAddAccountUsage    messageKey = "editcomAddAccountUsage"
+	messageEditcomRemoveAccountUsage messageKey = "editcomRemoveAccountUsage"
+	messageEditcomActionUsage        messageKey = "editcomActionUsage"
+	messageEditcomCooldownUsage      messageKey = "editcomCooldownUsage"
+	messageEditcomTypeUsage          messageKey = "editcomTypeUsage"
+	messageEditcomPermissionUsage    messageKey = "editcomPermissionUsage"
+	messageEditcomUserCooldownUsage  messageKey = "editcomUserCooldownUsage"
+	messageEditcomFeedbackUsage      messageKey = "editcomFeedbackUsage"
+	messageEditcomFormatVersionUsage messageKey = "editcomFormatVersionUsage"
+	messageEditcomEnabledUsage       messageKey = "editcomEnabledUsage"
+	messageCommandUpdated            messageKey = "commandUpdated"
+	messageAddcomInvalidProperty     messageKey = "addcomInvalidProperty"
+	messageInvalidReplyAction        messageKey = "invalidReplyAction"
+	messageMinCooldown               messageKey = "minCooldown"
+	messageMaxAccounts               messageKey = "maxAccounts"
+	messageLastAccount               messageKey = "lastAccount"
+	commandMinCooldown                          = 5
+	commandMaxAccounts                          = 4
 )
 
 func (b *bot) executeCommandEditcom(ctx context.Context, req *IncomingPossibleCommand) {
@@ -29,14 +40,14 @@ func (b *bot) executeCommandEditcom(ctx context.Context, req *IncomingPossibleCo
 		channelID = req.ChannelID
 	}
 
-	args := strings.Split(req.Command, " ")
+	args := tokenizeCommand(req.Command)
 
 	if len(args) < 4 {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageEditcomUsage, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomUsage, &req.MessageID)
 		return
 	}
 
-	commandName := strings.TrimPrefix(strings.ToLower(args[1]), b.commandPrefix)
+	commandName := strings.TrimPrefix(strings.ToLower(args[1]), req.Prefix)
 	property := strings.ToLower(args[2])
 
 	_, found, err := b.mainDB.FindUser(ctx, channelID)
@@ -46,7 +57,7 @@ func (b *bot) executeCommandEditcom(ctx context.Context, req *IncomingPossibleCo
 		return
 	}
 	if !found {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
 		return
 	}
 
@@ -57,29 +68,58 @@ func (b *bot) executeCommandEditcom(ctx context.Context, req *IncomingPossibleCo
 		return
 	}
 	if !found {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageCommandDoesNotExist, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandDoesNotExist, &req.MessageID)
 		return
 	}
 
 	switch property {
 	case "account":
 		if len(args) < 5 {
-			b.sendTwitchMessage(ctx, req.ChannelID, messageEditcomAccountUsage, &req.MessageID)
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomAccountUsage, &req.MessageID)
 			return
 		}
 
 		platform := strings.ToLower(args[3])
 		if _, ok := db.AllPlatforms[platform]; !ok {
-			b.sendTwitchMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
 			return
 		}
 		newUserName := strings.Join(args[4:], " ")
-		dbCmd.RLPlatform = db.RLPlatform(platform)
-		dbCmd.RLUsername = newUserName
+		dbCmd.RLAccounts[0] = db.RLAccount{Platform: db.RLPlatform(platform), Username: newUserName}
+
+	case "addaccount":
+		if len(args) < 5 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomAddAccountUsage, &req.MessageID)
+			return
+		}
+		if len(dbCmd.RLAccounts) >= commandMaxAccounts {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageMaxAccounts, &req.MessageID)
+			return
+		}
+
+		platform := strings.ToLower(args[3])
+		if _, ok := db.AllPlatforms[platform]; !ok {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
+			return
+		}
+		newUserName := strings.Join(args[4:], " ")
+		dbCmd.RLAccounts = append(dbCmd.RLAccounts, db.RLAccount{Platform: db.RLPlatform(platform), Username: newUserName})
+
+	case "removeaccount":
+		accountNumber, err := strconv.Atoi(args[3])
+		if len(args) != 4 || err != nil || accountNumber < 1 || accountNumber > len(dbCmd.RLAccounts) {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomRemoveAccountUsage, &req.MessageID)
+			return
+		}
+		if len(dbCmd.RLAccounts) == 1 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageLastAccount, &req.MessageID)
+			return
+		}
+		dbCmd.RLAccounts = append(dbCmd.RLAccounts[:accountNumber-1], dbCmd.RLAccounts[accountNumber:]...)
 
 	case "action":
 		if len(args) != 4 {
-			b.sendTwitchMessage(ctx, req.ChannelID, messageEditcomActionUsage, &req.MessageID)
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomActionUsage, &req.MessageID)
 			return
 		}
 		action := strings.ToLower(args[3])
@@ -91,41 +131,124 @@ func (b *bot) executeCommandEditcom(ctx context.Context, req *IncomingPossibleCo
 		case "mention":
 			dbCmd.TwitchResponseType = db.TwitchResponseTypeMention
 		default:
-			b.sendTwitchMessage(ctx, req.ChannelID, messageInvalidReplyAction, &req.MessageID)
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidReplyAction, &req.MessageID)
 			return
 		}
 
 	case "cooldown":
 		newCooldown, err := strconv.Atoi(args[3])
 		if len(args) != 4 || err != nil {
-			b.sendTwitchMessage(ctx, req.ChannelID, messageEditcomCooldownUsage, &req.MessageID)
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomCooldownUsage, &req.MessageID)
 			return
 		}
 		if newCooldown < commandMinCooldown {
-			b.sendTwitchMessage(ctx, req.ChannelID, messageMinCooldown, &req.MessageID)
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageMinCooldown, &req.MessageID)
 			return
 		}
 		dbCmd.CommandCooldownSeconds = newCooldown
 
+	case "usercooldown":
+		newCooldown, err := strconv.Atoi(args[3])
+		if len(args) != 4 || err != nil || newCooldown < 0 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomUserCooldownUsage, &req.MessageID)
+			return
+		}
+		dbCmd.UserCooldownSeconds = newCooldown
+
+	case "feedback":
+		if len(args) != 4 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomFeedbackUsage, &req.MessageID)
+			return
+		}
+		switch strings.ToLower(args[3]) {
+		case "silent":
+			dbCmd.CooldownFeedback = db.CooldownFeedbackSilent
+		case "whisper":
+			dbCmd.CooldownFeedback = db.CooldownFeedbackWhisper
+		case "reply":
+			dbCmd.CooldownFeedback = db.CooldownFeedbackReply
+		default:
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomFeedbackUsage, &req.MessageID)
+			return
+		}
+
+	case "type":
+		if len(args) != 4 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomTypeUsage, &req.MessageID)
+			return
+		}
+		// Compare commands compare the first account of the command with the account given in chat
+		switch strings.ToLower(args[3]) {
+		case "rank":
+			dbCmd.CommandType = db.CommandTypeRank
+		case "compare":
+			dbCmd.CommandType = db.CommandTypeCompare
+		default:
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomTypeUsage, &req.MessageID)
+			return
+		}
+
+	case "permission":
+		if len(args) != 4 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomPermissionUsage, &req.MessageID)
+			return
+		}
+		permission := strings.ToLower(args[3])
+		if _, ok := db.AllPermissionLevels[permission]; !ok {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomPermissionUsage, &req.MessageID)
+			return
+		}
+		dbCmd.PermissionLevel = db.PermissionLevel(permission)
+
+	case "formatversion":
+		// Version 1 formats use $(...) tokens, version 2 formats use the text/template syntax
+		switch {
+		case len(args) == 4 && args[3] == "1":
+			dbCmd.FormatVersion = db.FormatVersionTokens
+		case len(args) == 4 && args[3] == "2":
+			dbCmd.FormatVersion = db.FormatVersionTemplate
+		default:
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomFormatVersionUsage, &req.MessageID)
+			return
+		}
+
+	case "enabled":
+		// Disabled commands keep their configuration and are ignored in chat until they are enabled again
+		switch {
+		case len(args) == 4 && strings.ToLower(args[3]) == "on":
+			dbCmd.Enabled = true
+		case len(args) == 4 && strings.ToLower(args[3]) == "off":
+			dbCmd.Enabled = false
+		default:
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageEditcomEnabledUsage, &req.MessageID)
+			return
+		}
+
 	case "format":
-		formatStr := strings.Join(args[3:], " ")
+		formatStr := commandRest(req.Command, 3)
 		dbCmd.MessageFormat = formatStr
 
 	default:
-		b.sendTwitchMessage(ctx, req.ChannelID, messageAddcomInvalidProperty, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageAddcomInvalidProperty, &req.MessageID)
 		return
 	}
 
-	err = b.mainDB.UpdateCommand(ctx, dbCmd)
+	// The format is checked whenever it or the accounts it refers to change, so broken formats are never saved
+	if property == "format" || property == "formatversion" || property == "removeaccount" {
+		err = validateFormat(dbCmd.MessageFormat, dbCmd.FormatVersion, len(dbCmd.RLAccounts))
+		if err != nil {
+			b.sendTwitchMessage(ctx, req.ChannelID, localize(ctx, messageInvalidFormat)+err.Error(), &req.MessageID)
+			return
+		}
+	}
+
+	err = b.mainDB.UpdateCommand(ctx, dbCmd, commandAuthor(req))
 	if err != nil {
 		log.Ctx(ctx).Error().Err(err).Msg("Could not update command in db")
 		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
 		return
 	}
-	err = b.cacheDB.InvalidateCachedCommand(ctx, dbCmd.TwitchUserID, dbCmd.CommandName)
-	if err != nil {
-		log.Ctx(ctx).Warn().Err(err).Msg("Could not invalidate command in cache")
-	}
+	b.invalidateCachedCommand(ctx, dbCmd)
 
-	b.sendTwitchMessage(ctx, req.ChannelID, messageCommandUpdated, &req.MessageID)
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandUpdated, &req.MessageID)
 }
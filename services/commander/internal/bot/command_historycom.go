@@ -0,0 +1,122 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"context"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"slices"
+	"strings"
+)
+
+const (
+	messageHistorycomUsage      messageKey = "historycomUsage"
+	messageNoCommandHistory     messageKey = "noCommandHistory"
+	messageCommandHistory       messageKey = "commandHistory"
+	messageHistoryItemAdd       messageKey = "historyItemAdd"
+	messageHistoryItemEdit      messageKey = "historyItemEdit"
+	messageHistoryItemDelete    messageKey = "historyItemDelete"
+	messageHistoryItemUnchanged messageKey = "historyItemUnchanged"
+	messageHistoryItemUndone    messageKey = "historyItemUndone"
+	commandHistoryLimit                    = 5
+	commandHistoryTimeFormat               = "2006-01-02 15:04 UTC"
+)
+
+func (b *bot) executeCommandHistorycom(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) != 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageHistorycomUsage, &req.MessageID)
+		return
+	}
+
+	commandName, ok := b.resolveHistoryCommandName(ctx, req, channelID, args[1])
+	if !ok {
+		return
+	}
+
+	entries, err := b.mainDB.FindCommandHistory(ctx, channelID, commandName, commandHistoryLimit)
+	if err != nil {
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for command history")
+		return
+	}
+	if len(entries) == 0 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageNoCommandHistory, &req.MessageID)
+		return
+	}
+
+	items := make([]string, 0, len(entries))
+	for _, entry := range entries {
+		changedAt := entry.ChangedAt.UTC().Format(commandHistoryTimeFormat)
+
+		var item string
+		switch entry.ChangeType {
+		case db.CommandChangeAdd:
+			item = fmt.Sprintf(localize(ctx, messageHistoryItemAdd), entry.Version, entry.ChangedBy.TwitchUserLogin, changedAt)
+		case db.CommandChangeDelete:
+			item = fmt.Sprintf(localize(ctx, messageHistoryItemDelete), entry.Version, entry.ChangedBy.TwitchUserLogin, changedAt)
+		default:
+			changes := changedCommandProperties(entry.PreviousCommand, entry.Command)
+			if len(changes) == 0 {
+				item = fmt.Sprintf(localize(ctx, messageHistoryItemUnchanged), entry.Version, entry.ChangedBy.TwitchUserLogin, changedAt)
+			} else {
+				item = fmt.Sprintf(localize(ctx, messageHistoryItemEdit), entry.Version, strings.Join(changes, "/"), entry.ChangedBy.TwitchUserLogin, changedAt)
+			}
+		}
+		if entry.UndoneAt != nil && entry.UndoneByLogin != nil {
+			item += fmt.Sprintf(localize(ctx, messageHistoryItemUndone), *entry.UndoneByLogin)
+		}
+		items = append(items, item)
+	}
+
+	prefix := fmt.Sprintf(localize(ctx, messageCommandHistory), req.Prefix, commandName)
+	b.sendTwitchMessageList(ctx, req.ChannelID, prefix, items, ", ", &req.MessageID)
+}
+
+// changedCommandProperties names the !editcom properties that differ between two versions of a command
+func changedCommandProperties(previous *db.BotCommand, cmd *db.BotCommand) []string {
+	if previous == nil || cmd == nil {
+		return nil
+	}
+
+	var changes []string
+	if !slices.Equal(previous.RLAccounts, cmd.RLAccounts) {
+		changes = append(changes, "account")
+	}
+	if previous.TwitchResponseType != cmd.TwitchResponseType {
+		changes = append(changes, "action")
+	}
+	if previous.CommandCooldownSeconds != cmd.CommandCooldownSeconds {
+		changes = append(changes, "cooldown")
+	}
+	if previous.UserCooldownSeconds != cmd.UserCooldownSeconds {
+		changes = append(changes, "usercooldown")
+	}
+	if previous.CooldownFeedback != cmd.CooldownFeedback {
+		changes = append(changes, "feedback")
+	}
+	if previous.MessageFormat != cmd.MessageFormat {
+		changes = append(changes, "format")
+	}
+	if previous.FormatVersion != cmd.FormatVersion {
+		changes = append(changes, "formatversion")
+	}
+	if previous.CommandType != cmd.CommandType {
+		changes = append(changes, "type")
+	}
+	if previous.PermissionLevel != cmd.PermissionLevel {
+		changes = append(changes, "permission")
+	}
+	if previous.Enabled != cmd.Enabled {
+		changes = append(changes, "enabled")
+	}
+	return changes
+}
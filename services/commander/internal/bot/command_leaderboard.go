@@ -0,0 +1,120 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"RocketRankBot/services/commander/internal/formatter"
+	"RocketRankBot/services/commander/rpc/trackerggscraper"
+	"context"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	messageEnterlbUsage       messageKey = "enterlbUsage"
+	messageLeaderboardUsage   messageKey = "leaderboardUsage"
+	messageLeaderboardEntered messageKey = "leaderboardEntered"
+	messageLeaderboardLeft    messageKey = "leaderboardLeft"
+	messageInvalidPlaylist    messageKey = "invalidPlaylist"
+	messageLeaderboardEmpty   messageKey = "leaderboardEmpty"
+	messageLeaderboardHeader  messageKey = "leaderboardHeader"
+)
+
+const (
+	leaderboardDefaultPlaylist  = trackerggscraper.RankPlaylist_RANKED_2V2
+	leaderboardSize             = 10
+	leaderboardRankingSeparator = " | "
+	// leaderboardMaxRankAge leaves out ranks nobody has fetched for a while, entrants refresh them with !enterlb
+	leaderboardMaxRankAge = time.Hour * 24 * 7
+)
+
+func (b *bot) executeCommandEnterlb(ctx context.Context, req *IncomingPossibleCommand) {
+	args := tokenizeCommand(req.Command)
+	if len(args) < 3 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageEnterlbUsage, &req.MessageID)
+		return
+	}
+
+	platform := strings.ToLower(args[1])
+	if _, ok := db.AllPlatforms[platform]; !ok {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
+		return
+	}
+	account := db.RLAccount{Platform: db.RLPlatform(platform), Username: strings.Join(args[2:], " ")}
+
+	if !b.acquireRankLookupLimits(ctx, req) {
+		return
+	}
+
+	// Fetching the ranks once validates the account and seeds the rank history the leaderboard is built from
+	_, err := b.fetchRanks(ctx, account)
+	if err != nil {
+		b.sendTwitchMessage(ctx, req.ChannelID, b.getRankErrorMessage(ctx, account, err), &req.MessageID)
+		return
+	}
+
+	err = b.mainDB.UpsertLeaderboardEntry(ctx, &db.LeaderboardEntry{
+		ChannelID:       req.ChannelID,
+		TwitchUserID:    req.SenderID,
+		TwitchUserLogin: req.SenderLogin,
+		Account:         account,
+	})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not add leaderboard entry to db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageLeaderboardEntered, &req.MessageID)
+}
+
+func (b *bot) executeCommandLeavelb(ctx context.Context, req *IncomingPossibleCommand) {
+	err := b.mainDB.DeleteLeaderboardEntry(ctx, req.ChannelID, req.SenderID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not delete leaderboard entry from db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageLeaderboardLeft, &req.MessageID)
+}
+
+func (b *bot) executeCommandLeaderboard(ctx context.Context, req *IncomingPossibleCommand) {
+	args := tokenizeCommand(req.Command)
+	if len(args) > 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageLeaderboardUsage, &req.MessageID)
+		return
+	}
+
+	playlist := leaderboardDefaultPlaylist
+	if len(args) == 2 {
+		var ok bool
+		playlist, ok = formatter.ParsePlaylist(args[1])
+		if !ok {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPlaylist, &req.MessageID)
+			return
+		}
+	}
+
+	// The leaderboard is built from the recent rank history only, so it never causes requests to the rank service
+	rankings, err := b.mainDB.FindLeaderboard(ctx, req.ChannelID, int32(playlist), time.Now().Add(-leaderboardMaxRankAge), leaderboardSize)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for leaderboard")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if len(rankings) == 0 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageLeaderboardEmpty, &req.MessageID)
+		return
+	}
+
+	items := make([]string, 0, len(rankings))
+	for i, ranking := range rankings {
+		items = append(items, strconv.Itoa(i+1)+". "+ranking.TwitchUserLogin+" ("+formatter.ShortRankName(ranking.Rank, ranking.Division)+", "+strconv.Itoa(ranking.MMR)+")")
+	}
+
+	header := fmt.Sprintf(localize(ctx, messageLeaderboardHeader), formatter.PlaylistName(playlist, contextLanguage(ctx)))
+	b.sendTwitchMessageList(ctx, req.ChannelID, header, items, leaderboardRankingSeparator, &req.MessageID)
+}
@@ -5,19 +5,19 @@ import (
 	"github.com/rs/zerolog/log"
 )
 
-const messageBotLeft = "The bot has left your channel."
+const messageBotLeft messageKey = "botLeft"
 
 func (b *bot) executeCommandLeave(ctx context.Context, req *IncomingPossibleCommand) {
 	channelID := req.SenderID
 
 	if req.ChannelID != b.botChannelID && !req.IsBroadcaster && !req.IsAdmin {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageBroadcasterOnly, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBroadcasterOnly, &req.MessageID)
 		return
 	}
 
 	_, found, err := b.mainDB.FindUser(ctx, channelID)
 	if !found {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
 		return
 	}
 
@@ -35,7 +35,7 @@ func (b *bot) executeCommandLeave(ctx context.Context, req *IncomingPossibleComm
 		return
 	}
 
-	b.sendTwitchMessage(ctx, req.ChannelID, messageBotLeft, &req.MessageID)
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageBotLeft, &req.MessageID)
 
 	// Delete EventSub subscriptions last to allow response to go through
 	for _, sub := range *subs {
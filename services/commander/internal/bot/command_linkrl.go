@@ -0,0 +1,62 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"context"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+const (
+	messageLinkrlUsage       messageKey = "linkrlUsage"
+	messageAccountLinked     messageKey = "accountLinked"
+	messageMaxViewerAccounts messageKey = "maxViewerAccounts"
+	messageAccountsUnlinked  messageKey = "accountsUnlinked"
+	viewerMaxAccounts                   = 4
+)
+
+func (b *bot) executeCommandLinkrl(ctx context.Context, req *IncomingPossibleCommand) {
+	args := tokenizeCommand(req.Command)
+	if len(args) < 3 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageLinkrlUsage, &req.MessageID)
+		return
+	}
+
+	platform := strings.ToLower(args[1])
+	if _, ok := db.AllPlatforms[platform]; !ok {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
+		return
+	}
+	username := strings.Join(args[2:], " ")
+
+	accounts, err := b.mainDB.FindViewerAccounts(ctx, req.SenderID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for viewer accounts")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if len(accounts) >= viewerMaxAccounts {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageMaxViewerAccounts, &req.MessageID)
+		return
+	}
+
+	err = b.mainDB.AddViewerAccount(ctx, req.SenderID, db.RLAccount{Platform: db.RLPlatform(platform), Username: username})
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not add viewer account to db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageAccountLinked, &req.MessageID)
+}
+
+func (b *bot) executeCommandUnlinkrl(ctx context.Context, req *IncomingPossibleCommand) {
+	err := b.mainDB.DeleteViewerAccounts(ctx, req.SenderID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not delete viewer accounts from db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, messageAccountsUnlinked, &req.MessageID)
+}
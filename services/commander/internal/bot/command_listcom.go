@@ -3,10 +3,12 @@ package bot
 import (
 	"context"
 	"github.com/rs/zerolog/log"
+	"strings"
 )
 
 const (
-	messageNoCommandsConfigured = "No commands are configured for this channel."
+	messageNoCommandsConfigured messageKey = "noCommandsConfigured"
+	messageCommandList          messageKey = "commandList"
 )
 
 func (b *bot) executeCommandListcom(ctx context.Context, req *IncomingPossibleCommand) {
@@ -25,7 +27,7 @@ func (b *bot) executeCommandListcom(ctx context.Context, req *IncomingPossibleCo
 		return
 	}
 	if !found {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
 		return
 	}
 
@@ -36,25 +38,17 @@ func (b *bot) executeCommandListcom(ctx context.Context, req *IncomingPossibleCo
 		return
 	}
 	if len(*commands) == 0 {
-		b.sendTwitchMessage(ctx, req.ChannelID, messageNoCommandsConfigured, &req.MessageID)
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageNoCommandsConfigured, &req.MessageID)
 		return
 	}
 
-	resMsg := "Commands in this channel: "
-
-	firstCmdInList := true
+	commandNames := make([]string, 0, len(*commands))
 	for _, cmd := range *commands {
-		if len(resMsg)+len(cmd.CommandName)+3 > 500 {
-			b.sendTwitchMessage(ctx, req.ChannelID, resMsg, &req.MessageID)
-			resMsg = ""
-			firstCmdInList = true
-		}
-		if firstCmdInList {
-			resMsg += "!" + cmd.CommandName
-			firstCmdInList = false
-		} else {
-			resMsg += ", !" + cmd.CommandName
+		commandName := req.Prefix + cmd.CommandName
+		if len(cmd.Aliases) > 0 {
+			commandName += " (" + req.Prefix + strings.Join(cmd.Aliases, ", "+req.Prefix) + ")"
 		}
+		commandNames = append(commandNames, commandName)
 	}
-	b.sendTwitchMessage(ctx, req.ChannelID, resMsg, &req.MessageID)
+	b.sendTwitchMessageList(ctx, req.ChannelID, localize(ctx, messageCommandList), commandNames, ", ", &req.MessageID)
 }
@@ -0,0 +1,73 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/formatter"
+	"context"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+const (
+	messageLookupUsage        messageKey = "lookupUsage"
+	messageLookupEnabled      messageKey = "lookupEnabled"
+	messageLookupDisabled     messageKey = "lookupDisabled"
+	messageLookupFormatUpdate messageKey = "lookupFormatUpdate"
+)
+
+func (b *bot) executeCommandLookup(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) < 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageLookupUsage, &req.MessageID)
+		return
+	}
+
+	dbUser, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		return
+	}
+
+	var replyMessage messageKey
+
+	switch strings.ToLower(args[1]) {
+	case "on":
+		dbUser.LookupEnabled = true
+		replyMessage = messageLookupEnabled
+	case "off":
+		dbUser.LookupEnabled = false
+		replyMessage = messageLookupDisabled
+	case "format":
+		// An empty format resets the channel to the default lookup format
+		dbUser.LookupFormat = commandRest(req.Command, 2)
+		if err := formatter.ValidateFormat(dbUser.LookupFormat, 1); err != nil {
+			b.sendTwitchMessage(ctx, req.ChannelID, localize(ctx, messageInvalidFormat)+err.Error(), &req.MessageID)
+			return
+		}
+		replyMessage = messageLookupFormatUpdate
+	default:
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageLookupUsage, &req.MessageID)
+		return
+	}
+
+	err = b.mainDB.UpdateUserLookupSettings(ctx, channelID, dbUser.LookupEnabled, dbUser.LookupFormat)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not update lookup settings in db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+}
@@ -0,0 +1,41 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"context"
+	"github.com/rs/zerolog/log"
+	"strconv"
+	"strings"
+)
+
+const (
+	messageNoAccountsLinked messageKey = "noAccountsLinked"
+	myrankFormat                       = "$(name): 1v1 $(1.r.s) ($(1.m)) | 2v2 $(2.r.s) ($(2.m)) | 3v3 $(3.r.s) ($(3.m))"
+	myrankAccountSeparator             = " || "
+)
+
+func (b *bot) executeCommandMyrank(ctx context.Context, req *IncomingPossibleCommand) {
+	accounts, err := b.mainDB.FindViewerAccounts(ctx, req.SenderID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for viewer accounts")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if len(accounts) == 0 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageNoAccountsLinked, &req.MessageID)
+		return
+	}
+
+	if !b.acquireRankLookupLimits(ctx, req) {
+		return
+	}
+
+	// The format is repeated for every linked account, with all tokens pointing to that account
+	formats := make([]string, len(accounts))
+	for i := range accounts {
+		formats[i] = strings.ReplaceAll(myrankFormat, "$(", "$(acc"+strconv.Itoa(i+1)+".")
+	}
+
+	replyMessage := b.getRankMessage(ctx, "", newInvocation(ctx, req), accounts, strings.Join(formats, myrankAccountSeparator), db.FormatVersionTokens)
+	b.sendTwitchMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+}
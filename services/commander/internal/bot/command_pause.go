@@ -0,0 +1,53 @@
+package bot
+
+import (
+	"context"
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	messageCommandsPaused  messageKey = "commandsPaused"
+	messageCommandsResumed messageKey = "commandsResumed"
+)
+
+func (b *bot) executeCommandPause(ctx context.Context, req *IncomingPossibleCommand) {
+	b.setCommandsPaused(ctx, req, true, messageCommandsPaused)
+}
+
+func (b *bot) executeCommandResume(ctx context.Context, req *IncomingPossibleCommand) {
+	b.setCommandsPaused(ctx, req, false, messageCommandsResumed)
+}
+
+// setCommandsPaused turns all custom commands of a channel off or on, keeping the enabled flag of each command
+func (b *bot) setCommandsPaused(ctx context.Context, req *IncomingPossibleCommand, paused bool, replyMessage messageKey) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	_, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		return
+	}
+
+	err = b.mainDB.UpdateUserCommandsPaused(ctx, channelID, paused)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not update paused flag in db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	// The paused flag is cached with every command of the channel
+	b.invalidateChannelCommands(ctx, channelID)
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+}
@@ -0,0 +1,80 @@
+package bot
+
+import (
+	"context"
+	"github.com/rs/zerolog/log"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+const (
+	messagePrefixUsage   messageKey = "prefixUsage"
+	messageInvalidPrefix messageKey = "invalidPrefix"
+	messagePrefixUpdated messageKey = "prefixUpdated"
+	messagePrefixReset   messageKey = "prefixReset"
+	maxPrefixLength                 = 5
+)
+
+func (b *bot) executeCommandPrefix(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) != 2 || len(args[1]) == 0 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messagePrefixUsage, &req.MessageID)
+		return
+	}
+
+	_, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		return
+	}
+
+	// An empty prefix makes the channel use the default prefix
+	prefix := ""
+	replyMessage := messagePrefixReset
+	if strings.ToLower(args[1]) != "reset" {
+		prefix = args[1]
+		replyMessage = messagePrefixUpdated
+		if !isValidPrefix(prefix) {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPrefix, &req.MessageID)
+			return
+		}
+	}
+
+	err = b.mainDB.UpdateUserCommandPrefix(ctx, channelID, prefix)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not update command prefix in db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	b.invalidateChannelSettings(ctx, channelID)
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+}
+
+// isValidPrefix rejects prefixes that are too long or would clash with Twitch chat commands and the ping prefix.
+// Prefixes starting with a letter or digit would turn ordinary chat messages into command lookups.
+func isValidPrefix(prefix string) bool {
+	if prefix == "" || utf8.RuneCountInString(prefix) > maxPrefixLength {
+		return false
+	}
+	first, _ := utf8.DecodeRuneInString(prefix)
+	if unicode.IsLetter(first) || unicode.IsDigit(first) {
+		return false
+	}
+	return !strings.HasPrefix(prefix, "/") && !strings.HasPrefix(prefix, ".") && !strings.HasPrefix(prefix, "@")
+}
@@ -0,0 +1,77 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"context"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+const (
+	messageRankUsage        messageKey = "rankUsage"
+	rankLookupDefaultFormat            = "$(name) | Ranked 1v1: $(1.r) Div $(1.d) ($(1.m)) | Ranked 2v2: $(2.r) Div $(2.d) ($(2.m)) | Ranked 3v3: $(3.r) Div $(3.d) ($(3.m))"
+)
+
+func (b *bot) executeCommandRank(ctx context.Context, req *IncomingPossibleCommand) {
+	format := rankLookupDefaultFormat
+
+	if req.ChannelID != b.botChannelID {
+		dbUser, found, err := b.mainDB.FindUser(ctx, req.ChannelID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+			return
+		}
+		if !found || !dbUser.LookupEnabled {
+			return
+		}
+		if len(dbUser.LookupFormat) > 0 {
+			format = dbUser.LookupFormat
+		}
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) < 3 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageRankUsage, &req.MessageID)
+		return
+	}
+
+	platform := strings.ToLower(args[1])
+	if _, ok := db.AllPlatforms[platform]; !ok {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidPlatform, &req.MessageID)
+		return
+	}
+	username := strings.Join(args[2:], " ")
+
+	if !b.acquireRankLookupLimits(ctx, req) {
+		return
+	}
+
+	replyMessage := b.getRankMessage(ctx, "", newInvocation(ctx, req), []db.RLAccount{{Platform: db.RLPlatform(platform), Username: username}}, format, db.FormatVersionTokens)
+	b.sendTwitchMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+}
+
+// acquireRankLookupLimits reports whether the sender may look up ranks of arbitrary accounts in the channel
+func (b *bot) acquireRankLookupLimits(ctx context.Context, req *IncomingPossibleCommand) bool {
+	// Per-user limits are checked first, so a single spamming viewer can not use up the channel limit
+	allowed, err := b.cacheDB.AcquireRateLimit(ctx, "lookup:user:"+req.SenderID, 1, b.rankLookup.userCooldown)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not acquire user rate limit for rank lookup")
+		return false
+	}
+	if !allowed {
+		log.Ctx(ctx).Debug().Str("sender-id", req.SenderID).Msg("Rank lookup rate limited for user")
+		return false
+	}
+
+	allowed, err = b.cacheDB.AcquireRateLimit(ctx, "lookup:channel:"+req.ChannelID, b.rankLookup.channelLimit, b.rankLookup.channelWindow)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not acquire channel rate limit for rank lookup")
+		return false
+	}
+	if !allowed {
+		log.Ctx(ctx).Debug().Str("channel-id", req.ChannelID).Msg("Rank lookup rate limited for channel")
+		return false
+	}
+
+	return true
+}
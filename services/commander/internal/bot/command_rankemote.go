@@ -0,0 +1,126 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/formatter"
+	"context"
+	"github.com/rs/zerolog/log"
+	"slices"
+	"strings"
+)
+
+const (
+	messageRankemoteUsage       messageKey = "rankemoteUsage"
+	messageRankemoteSetUsage    messageKey = "rankemoteSetUsage"
+	messageRankemoteRemoveUsage messageKey = "rankemoteRemoveUsage"
+	messageInvalidRank          messageKey = "invalidRank"
+	messageRankemoteSet         messageKey = "rankemoteSet"
+	messageRankemoteRemoved     messageKey = "rankemoteRemoved"
+	messageRankemotesCleared    messageKey = "rankemotesCleared"
+	messageNoRankemotes         messageKey = "noRankemotes"
+	messageRankemoteList        messageKey = "rankemoteList"
+)
+
+func (b *bot) executeCommandRankemote(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) < 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageRankemoteUsage, &req.MessageID)
+		return
+	}
+
+	_, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		return
+	}
+
+	var replyMessage messageKey
+
+	switch strings.ToLower(args[1]) {
+	case "set":
+		if len(args) != 4 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageRankemoteSetUsage, &req.MessageID)
+			return
+		}
+		rank, ok := formatter.ParseRank(args[2])
+		if !ok {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidRank, &req.MessageID)
+			return
+		}
+		err = b.mainDB.UpsertRankEmote(ctx, channelID, rank, args[3])
+		replyMessage = messageRankemoteSet
+
+	case "remove":
+		if len(args) != 3 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageRankemoteRemoveUsage, &req.MessageID)
+			return
+		}
+		rank, ok := formatter.ParseRank(args[2])
+		if !ok {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidRank, &req.MessageID)
+			return
+		}
+		err = b.mainDB.DeleteRankEmotes(ctx, channelID, rank)
+		replyMessage = messageRankemoteRemoved
+
+	case "clear":
+		err = b.mainDB.DeleteRankEmotes(ctx, channelID, -1)
+		replyMessage = messageRankemotesCleared
+
+	case "list":
+		b.sendRankEmoteList(ctx, req, channelID)
+		return
+
+	default:
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageRankemoteUsage, &req.MessageID)
+		return
+	}
+
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not update rank emotes in db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	// Rank emotes are cached with every command of the channel
+	b.invalidateChannelCommands(ctx, channelID)
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+}
+
+func (b *bot) sendRankEmoteList(ctx context.Context, req *IncomingPossibleCommand, channelID string) {
+	emotes, err := b.mainDB.FindRankEmotes(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for rank emotes")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if len(emotes) == 0 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageNoRankemotes, &req.MessageID)
+		return
+	}
+
+	ranks := make([]int, 0, len(emotes))
+	for rank := range emotes {
+		ranks = append(ranks, rank)
+	}
+	slices.Sort(ranks)
+
+	items := make([]string, 0, len(ranks))
+	for _, rank := range ranks {
+		items = append(items, formatter.ShortRankTierName(rank)+": "+emotes[rank])
+	}
+	b.sendTwitchMessageList(ctx, req.ChannelID, localize(ctx, messageRankemoteList), items, ", ", &req.MessageID)
+}
@@ -0,0 +1,58 @@
+package bot
+
+import (
+	"context"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+const (
+	messageSetlangUsage     messageKey = "setlangUsage"
+	messageLanguageUpdated  messageKey = "languageUpdated"
+	messageLanguageNotFound messageKey = "languageNotFound"
+)
+
+func (b *bot) executeCommandSetlang(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) != 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageSetlangUsage, &req.MessageID)
+		return
+	}
+
+	language := strings.ToLower(args[1])
+	if _, ok := translations[language]; !ok {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageLanguageNotFound, &req.MessageID)
+		return
+	}
+
+	_, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		return
+	}
+
+	err = b.mainDB.UpdateUserLanguage(ctx, channelID, language)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not update language in db")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	// The language is cached with the channel settings
+	b.invalidateChannelSettings(ctx, channelID)
+
+	b.sendLocalizedMessage(withLanguage(ctx, language), req.ChannelID, messageLanguageUpdated, &req.MessageID)
+}
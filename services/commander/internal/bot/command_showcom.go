@@ -0,0 +1,96 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/formatter"
+	"context"
+	"github.com/rs/zerolog/log"
+	"strconv"
+	"strings"
+)
+
+const (
+	messageShowcomUsage         messageKey = "showcomUsage"
+	messageShowcomAliases       messageKey = "showcomAliases"
+	messageShowcomType          messageKey = "showcomType"
+	messageShowcomAccounts      messageKey = "showcomAccounts"
+	messageShowcomAction        messageKey = "showcomAction"
+	messageShowcomPermission    messageKey = "showcomPermission"
+	messageShowcomCooldown      messageKey = "showcomCooldown"
+	messageShowcomUserCooldown  messageKey = "showcomUserCooldown"
+	messageShowcomFeedback      messageKey = "showcomFeedback"
+	messageShowcomFormatVersion messageKey = "showcomFormatVersion"
+	messageShowcomEnabled       messageKey = "showcomEnabled"
+	messageShowcomFormat        messageKey = "showcomFormat"
+	messageShowcomNone          messageKey = "showcomNone"
+	messageShowcomOn            messageKey = "showcomOn"
+	messageShowcomOff           messageKey = "showcomOff"
+)
+
+const showcomSeparator = " | "
+
+func (b *bot) executeCommandShowcom(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) != 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageShowcomUsage, &req.MessageID)
+		return
+	}
+
+	commandName := strings.TrimPrefix(strings.ToLower(args[1]), req.Prefix)
+
+	dbCmd, found, err := b.mainDB.FindCommand(ctx, channelID, commandName)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for command")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandDoesNotExist, &req.MessageID)
+		return
+	}
+
+	accounts := make([]string, 0, len(dbCmd.RLAccounts))
+	for i, account := range dbCmd.RLAccounts {
+		accounts = append(accounts, strconv.Itoa(i+1)+". "+string(account.Platform)+" "+account.Username)
+	}
+
+	aliases := localize(ctx, messageShowcomNone)
+	if len(dbCmd.Aliases) > 0 {
+		aliases = req.Prefix + strings.Join(dbCmd.Aliases, ", "+req.Prefix)
+	}
+
+	userCooldown := localize(ctx, messageShowcomOff)
+	if dbCmd.UserCooldownSeconds > 0 {
+		userCooldown = strconv.Itoa(dbCmd.UserCooldownSeconds) + "s"
+	}
+
+	enabled := localize(ctx, messageShowcomOff)
+	if dbCmd.Enabled {
+		enabled = localize(ctx, messageShowcomOn)
+	}
+
+	items := []string{
+		req.Prefix + dbCmd.CommandName,
+		localize(ctx, messageShowcomAliases) + aliases,
+		localize(ctx, messageShowcomType) + string(dbCmd.CommandType),
+		localize(ctx, messageShowcomAccounts) + strings.Join(accounts, ", "),
+		localize(ctx, messageShowcomAction) + string(dbCmd.TwitchResponseType),
+		localize(ctx, messageShowcomPermission) + string(dbCmd.PermissionLevel),
+		localize(ctx, messageShowcomCooldown) + strconv.Itoa(dbCmd.CommandCooldownSeconds) + "s",
+		localize(ctx, messageShowcomUserCooldown) + userCooldown,
+		localize(ctx, messageShowcomFeedback) + string(dbCmd.CooldownFeedback),
+		localize(ctx, messageShowcomFormatVersion) + strconv.Itoa(int(dbCmd.FormatVersion)),
+		localize(ctx, messageShowcomEnabled) + enabled,
+	}
+	// Formats can be longer than a single message, so they are split into parts which are sent on their own
+	items = append(items, splitMessage(localize(ctx, messageShowcomFormat)+formatter.EscapeTokens(dbCmd.MessageFormat), twitchMaxMessageLength)...)
+
+	b.sendTwitchMessageList(ctx, req.ChannelID, "", items, showcomSeparator, &req.MessageID)
+}
@@ -0,0 +1,80 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"RocketRankBot/services/commander/internal/formatter"
+	"context"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+const (
+	messageTestformatUsage messageKey = "testformatUsage"
+)
+
+// executeCommandTestformat renders a format without saving anything. The format is rendered against sample data, or
+// against the live ranks of a command's accounts if the format is preceded by the name of that command. Sample data
+// previews use version 1 formats unless the format is preceded by v2.
+func (b *bot) executeCommandTestformat(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) < 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageTestformatUsage, &req.MessageID)
+		return
+	}
+
+	invocation := &formatter.Invocation{Sender: req.SenderLogin, Channel: req.ChannelLogin, Language: contextLanguage(ctx)}
+
+	// The command name needs the prefix, so formats starting with a word are not mistaken for a command
+	if strings.HasPrefix(args[1], req.Prefix) && len(args) > 2 {
+		commandName := strings.TrimPrefix(strings.ToLower(args[1]), req.Prefix)
+		format := commandRest(req.Command, 2)
+
+		dbCmd, found, err := b.mainDB.FindCommand(ctx, channelID, commandName)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Could not query db for command")
+			b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+			return
+		}
+		if !found {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandDoesNotExist, &req.MessageID)
+			return
+		}
+
+		err = validateFormat(format, dbCmd.FormatVersion, len(dbCmd.RLAccounts))
+		if err != nil {
+			b.sendTwitchMessage(ctx, req.ChannelID, localize(ctx, messageInvalidFormat)+err.Error(), &req.MessageID)
+			return
+		}
+
+		replyMessage := b.getRankMessagePreview(ctx, channelID, invocation, dbCmd.RLAccounts, format, dbCmd.FormatVersion)
+		b.sendTwitchMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+		return
+	}
+
+	formatVersion := db.FormatVersionTokens
+	format := commandRest(req.Command, 1)
+	if strings.ToLower(args[1]) == "v2" && len(args) > 2 {
+		formatVersion = db.FormatVersionTemplate
+		format = commandRest(req.Command, 2)
+	}
+
+	err := validateFormat(format, formatVersion, 1)
+	if err != nil {
+		b.sendTwitchMessage(ctx, req.ChannelID, localize(ctx, messageInvalidFormat)+err.Error(), &req.MessageID)
+		return
+	}
+
+	if formatVersion == db.FormatVersionTemplate {
+		b.sendTwitchMessage(ctx, req.ChannelID, b.formatTemplate(ctx, formatter.SampleAccounts(1), invocation, format), &req.MessageID)
+		return
+	}
+	b.sendTwitchMessage(ctx, req.ChannelID, formatter.FormatRankString(formatter.SampleAccounts(1), invocation, format), &req.MessageID)
+}
@@ -0,0 +1,146 @@
+package bot
+
+import (
+	"context"
+	"github.com/rs/zerolog/log"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	messageTriggerUsage       messageKey = "triggerUsage"
+	messageTriggerAddUsage    messageKey = "triggerAddUsage"
+	messageTriggerRemoveUsage messageKey = "triggerRemoveUsage"
+	messageInvalidKeyword     messageKey = "invalidKeyword"
+	messageMaxTriggers        messageKey = "maxTriggers"
+	messageTriggerAdded       messageKey = "triggerAdded"
+	messageTriggerRemoved     messageKey = "triggerRemoved"
+	messageTriggerNotFound    messageKey = "triggerNotFound"
+	messageNoTriggers         messageKey = "noTriggers"
+	messageTriggerList        messageKey = "triggerList"
+	minKeywordLength                     = 3
+	maxKeywordLength                     = 100
+	// maxTriggers keeps matching cheap, as every chat message of a channel is matched against all of its triggers
+	maxTriggers = 20
+)
+
+func (b *bot) executeCommandTrigger(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) < 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageTriggerUsage, &req.MessageID)
+		return
+	}
+
+	_, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageBotNotJoined, &req.MessageID)
+		return
+	}
+
+	triggers, err := b.mainDB.FindKeywordTriggers(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for keyword triggers")
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		return
+	}
+
+	var replyMessage messageKey
+
+	switch strings.ToLower(args[1]) {
+	case "add":
+		// Keywords can contain spaces, the last argument is the command
+		if len(args) < 4 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageTriggerAddUsage, &req.MessageID)
+			return
+		}
+		keyword := strings.ToLower(strings.Join(args[2:len(args)-1], " "))
+		if utf8.RuneCountInString(keyword) < minKeywordLength || utf8.RuneCountInString(keyword) > maxKeywordLength {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageInvalidKeyword, &req.MessageID)
+			return
+		}
+		if _, ok := triggers[keyword]; !ok && len(triggers) >= maxTriggers {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageMaxTriggers, &req.MessageID)
+			return
+		}
+
+		commandName := strings.TrimPrefix(strings.ToLower(args[len(args)-1]), req.Prefix)
+		dbCmd, found, err := b.mainDB.FindCommand(ctx, channelID, commandName)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Could not query db for command")
+			b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+			return
+		}
+		if !found {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageCommandDoesNotExist, &req.MessageID)
+			return
+		}
+
+		// Triggers point to the command itself, so they are removed together with it
+		err = b.mainDB.UpsertKeywordTrigger(ctx, channelID, keyword, dbCmd.CommandName)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Could not add keyword trigger to db")
+			b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+			return
+		}
+		replyMessage = messageTriggerAdded
+
+	case "remove":
+		if len(args) < 3 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageTriggerRemoveUsage, &req.MessageID)
+			return
+		}
+		keyword := strings.ToLower(strings.Join(args[2:], " "))
+		if _, ok := triggers[keyword]; !ok {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageTriggerNotFound, &req.MessageID)
+			return
+		}
+
+		err = b.mainDB.DeleteKeywordTrigger(ctx, channelID, keyword)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Could not delete keyword trigger from db")
+			b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+			return
+		}
+		replyMessage = messageTriggerRemoved
+
+	case "list":
+		if len(triggers) == 0 {
+			b.sendLocalizedMessage(ctx, req.ChannelID, messageNoTriggers, &req.MessageID)
+			return
+		}
+		keywords := make([]string, 0, len(triggers))
+		for keyword := range triggers {
+			keywords = append(keywords, keyword)
+		}
+		slices.Sort(keywords)
+
+		items := make([]string, 0, len(keywords))
+		for _, keyword := range keywords {
+			items = append(items, "\""+keyword+"\": "+req.Prefix+triggers[keyword])
+		}
+		b.sendTwitchMessageList(ctx, req.ChannelID, localize(ctx, messageTriggerList), items, ", ", &req.MessageID)
+		return
+
+	default:
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageTriggerUsage, &req.MessageID)
+		return
+	}
+
+	b.invalidateChannelSettings(ctx, channelID)
+
+	b.sendLocalizedMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+}
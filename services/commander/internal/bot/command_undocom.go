@@ -0,0 +1,87 @@
+package bot
+
+import (
+	"context"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"strings"
+)
+
+const (
+	messageUndocomUsage  messageKey = "undocomUsage"
+	messageNothingToUndo messageKey = "nothingToUndo"
+	messageUndoAdd       messageKey = "undoAdd"
+	messageUndoEdit      messageKey = "undoEdit"
+	messageUndoDelete    messageKey = "undoDelete"
+)
+
+func (b *bot) executeCommandUndocom(ctx context.Context, req *IncomingPossibleCommand) {
+	var channelID string
+
+	if req.ChannelID == b.botChannelID {
+		channelID = req.SenderID
+	} else {
+		channelID = req.ChannelID
+	}
+
+	args := tokenizeCommand(req.Command)
+	if len(args) != 2 {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageUndocomUsage, &req.MessageID)
+		return
+	}
+
+	commandName, ok := b.resolveHistoryCommandName(ctx, req, channelID, args[1])
+	if !ok {
+		return
+	}
+
+	entry, found, err := b.mainDB.UndoCommandChange(ctx, channelID, commandName, commandAuthor(req))
+	if err != nil {
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		log.Ctx(ctx).Error().Err(err).Msg("Could not undo command change in db")
+		return
+	}
+	if !found {
+		b.sendLocalizedMessage(ctx, req.ChannelID, messageNothingToUndo, &req.MessageID)
+		return
+	}
+
+	// Both versions are invalidated, as aliases may differ between them
+	if entry.Command != nil {
+		b.invalidateCachedCommand(ctx, entry.Command)
+	}
+	if entry.PreviousCommand != nil {
+		b.invalidateCachedCommand(ctx, entry.PreviousCommand)
+	}
+
+	var replyMessage string
+	switch {
+	case entry.PreviousCommand == nil:
+		// Keyword triggers are removed together with the command by the database
+		b.invalidateChannelSettings(ctx, channelID)
+		replyMessage = fmt.Sprintf(localize(ctx, messageUndoAdd), req.Prefix, commandName)
+	case entry.Command == nil:
+		replyMessage = fmt.Sprintf(localize(ctx, messageUndoDelete), req.Prefix, commandName)
+	default:
+		replyMessage = fmt.Sprintf(localize(ctx, messageUndoEdit), req.Prefix, commandName, entry.ChangedBy.TwitchUserLogin)
+	}
+
+	b.sendTwitchMessage(ctx, req.ChannelID, replyMessage, &req.MessageID)
+}
+
+// resolveHistoryCommandName resolves an alias to its command. Names of deleted commands are used as they were typed, so
+// their history can still be accessed.
+func (b *bot) resolveHistoryCommandName(ctx context.Context, req *IncomingPossibleCommand, channelID string, arg string) (string, bool) {
+	commandName := strings.TrimPrefix(strings.ToLower(arg), req.Prefix)
+
+	dbCmd, found, err := b.mainDB.FindCommand(ctx, channelID, commandName)
+	if err != nil {
+		b.sendTwitchMessage(ctx, req.ChannelID, getMessageInternalErrorWithCtx(ctx), &req.MessageID)
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for command")
+		return "", false
+	}
+	if found {
+		return dbCmd.CommandName, true
+	}
+	return commandName, true
+}
@@ -0,0 +1,62 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"context"
+	"fmt"
+	"github.com/rs/zerolog/log"
+	"math"
+	"time"
+)
+
+const messageCommandOnCooldown messageKey = "commandOnCooldown"
+
+// acquireCommandCooldown starts the channel-wide and per-user cooldowns of a command and reports whether the sender may
+// execute it
+func (b *bot) acquireCommandCooldown(ctx context.Context, req *IncomingPossibleCommand, commandName string, command *db.CachedCommand) bool {
+	acquired, remaining, err := b.cacheDB.AcquireCommandCooldown(ctx, req.ChannelID, commandName, req.SenderID,
+		time.Second*time.Duration(command.CommandCooldownSeconds), time.Second*time.Duration(command.UserCooldownSeconds))
+	if err != nil {
+		// Failing open keeps commands usable while the cache is unavailable
+		log.Ctx(ctx).Error().Err(err).Msg("Error acquiring command cooldown")
+		return true
+	}
+	if !acquired {
+		b.sendCooldownFeedback(ctx, req, commandName, command.CooldownFeedback, remaining)
+	}
+
+	return acquired
+}
+
+// sendCooldownFeedback tells the sender how long a command is still on cooldown. The feedback is sent at most once per
+// remaining cooldown, so spamming a command does not make the bot spam as well.
+func (b *bot) sendCooldownFeedback(ctx context.Context, req *IncomingPossibleCommand, commandName string, feedback db.CooldownFeedback, remaining time.Duration) {
+	if feedback != db.CooldownFeedbackWhisper && feedback != db.CooldownFeedbackReply {
+		return
+	}
+	if remaining <= 0 {
+		return
+	}
+
+	acquired, err := b.cacheDB.AcquireCooldownFeedback(ctx, req.ChannelID, commandName, req.SenderID, remaining)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error acquiring cooldown feedback")
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	remainingSeconds := int(math.Ceil(remaining.Seconds()))
+	message := fmt.Sprintf(localize(ctx, messageCommandOnCooldown), req.Prefix+commandName, remainingSeconds)
+
+	if feedback == db.CooldownFeedbackReply {
+		b.sendTwitchMessage(ctx, req.ChannelID, message, &req.MessageID)
+		return
+	}
+
+	err = b.twitchAPI.SendWhisper(ctx, req.SenderID, message)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error sending cooldown whisper")
+	}
+}
@@ -0,0 +1,65 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/formatter"
+	"RocketRankBot/services/commander/rpc/trackerggscraper"
+	"context"
+	"github.com/rs/zerolog/log"
+	"sync"
+	"time"
+)
+
+const (
+	divisionThresholdsRefreshInterval = time.Hour
+	// divisionThresholdsRetryInterval keeps a failing db from being queried for every formatted rank
+	divisionThresholdsRetryInterval = time.Minute
+	// divisionThresholdsWindow only considers recent observations, as division boundaries move over a season
+	divisionThresholdsWindow = time.Hour * 24 * 14
+)
+
+// divisionThresholdCache keeps the division thresholds derived from the rank history in memory, as computing them
+// requires aggregating over all recent observations
+type divisionThresholdCache struct {
+	lock          sync.Mutex
+	thresholds    formatter.DivisionThresholds
+	nextRefreshAt time.Time
+	// refreshing is set while one caller queries the db, the others keep using the current thresholds meanwhile
+	refreshing bool
+}
+
+func (b *bot) getDivisionThresholds(ctx context.Context) formatter.DivisionThresholds {
+	b.divisionThresholds.lock.Lock()
+	thresholds := b.divisionThresholds.thresholds
+	if b.divisionThresholds.refreshing || time.Now().Before(b.divisionThresholds.nextRefreshAt) {
+		b.divisionThresholds.lock.Unlock()
+		return thresholds
+	}
+	b.divisionThresholds.refreshing = true
+	b.divisionThresholds.lock.Unlock()
+
+	dbThresholds, err := b.mainDB.FindDivisionThresholds(ctx, time.Now().Add(-divisionThresholdsWindow))
+
+	b.divisionThresholds.lock.Lock()
+	defer b.divisionThresholds.lock.Unlock()
+	b.divisionThresholds.refreshing = false
+
+	if err != nil {
+		// stale thresholds are still better than none
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for division thresholds")
+		b.divisionThresholds.nextRefreshAt = time.Now().Add(divisionThresholdsRetryInterval)
+		return thresholds
+	}
+
+	thresholds = make(formatter.DivisionThresholds)
+	for _, threshold := range dbThresholds {
+		playlist := trackerggscraper.RankPlaylist(threshold.Playlist)
+		if thresholds[playlist] == nil {
+			thresholds[playlist] = make(map[formatter.RankDivision]int)
+		}
+		thresholds[playlist][formatter.RankDivision{Rank: threshold.Rank, Division: threshold.Division}] = threshold.MMR
+	}
+
+	b.divisionThresholds.thresholds = thresholds
+	b.divisionThresholds.nextRefreshAt = time.Now().Add(divisionThresholdsRefreshInterval)
+	return thresholds
+}
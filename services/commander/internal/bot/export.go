@@ -0,0 +1,271 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// CommandExportVersion is increased whenever the structure of CommandExport changes incompatibly
+const CommandExportVersion = 1
+
+const commandNameMaxLength = 64
+
+var ErrChannelNotJoined = errors.New("the bot has not joined the channel")
+
+// CommandExport is the versioned JSON document the commands of a channel are exported to and imported from
+type CommandExport struct {
+	Version  int               `json:"version"`
+	Commands []ExportedCommand `json:"commands"`
+}
+
+// ExportedCommand is a command of a CommandExport. Properties that are left out of an import use the !addcom defaults.
+type ExportedCommand struct {
+	Name          string            `json:"name"`
+	Aliases       []string          `json:"aliases,omitempty"`
+	Type          string            `json:"type"`
+	Accounts      []ExportedAccount `json:"accounts"`
+	Action        string            `json:"action"`
+	Permission    string            `json:"permission"`
+	Cooldown      int               `json:"cooldown"`
+	UserCooldown  int               `json:"userCooldown"`
+	Feedback      string            `json:"feedback"`
+	Format        string            `json:"format"`
+	FormatVersion int               `json:"formatVersion"`
+	// Enabled is a pointer so commands without the property are imported as enabled
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type ExportedAccount struct {
+	Platform string `json:"platform"`
+	Username string `json:"username"`
+}
+
+// CommandImportError describes why a command of an import was rejected
+type CommandImportError struct {
+	Command string
+	Reason  string
+}
+
+func (e *CommandImportError) Error() string {
+	if e.Command == "" {
+		return e.Reason
+	}
+	return "command " + e.Command + ": " + e.Reason
+}
+
+func (b *bot) ExportCommands(ctx context.Context, channelID string) (*CommandExport, error) {
+	commands, err := b.mainDB.FindUserCommands(ctx, channelID)
+	if err != nil {
+		return nil, err
+	}
+
+	export := CommandExport{
+		Version:  CommandExportVersion,
+		Commands: make([]ExportedCommand, 0, len(*commands)),
+	}
+	for _, cmd := range *commands {
+		accounts := make([]ExportedAccount, 0, len(cmd.RLAccounts))
+		for _, account := range cmd.RLAccounts {
+			accounts = append(accounts, ExportedAccount{Platform: string(account.Platform), Username: account.Username})
+		}
+
+		export.Commands = append(export.Commands, ExportedCommand{
+			Name:          cmd.CommandName,
+			Aliases:       cmd.Aliases,
+			Type:          string(cmd.CommandType),
+			Accounts:      accounts,
+			Action:        string(cmd.TwitchResponseType),
+			Permission:    string(cmd.PermissionLevel),
+			Cooldown:      cmd.CommandCooldownSeconds,
+			UserCooldown:  cmd.UserCooldownSeconds,
+			Feedback:      string(cmd.CooldownFeedback),
+			Format:        cmd.MessageFormat,
+			FormatVersion: int(cmd.FormatVersion),
+			Enabled:       &cmd.Enabled,
+		})
+	}
+
+	return &export, nil
+}
+
+// ImportCommands validates all commands of an export and adds them to a channel, overwriting existing commands with the
+// same name. Validation problems are returned as a CommandImportError, in which case nothing is imported.
+func (b *bot) ImportCommands(ctx context.Context, channelID string, export *CommandExport, importedBy db.CommandAuthor) (int, error) {
+	if export.Version != CommandExportVersion {
+		return 0, &CommandImportError{Reason: fmt.Sprintf("unsupported export version %d", export.Version)}
+	}
+
+	_, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		return 0, err
+	}
+	if !found {
+		return 0, ErrChannelNotJoined
+	}
+
+	existing, err := b.mainDB.FindUserCommands(ctx, channelID)
+	if err != nil {
+		return 0, err
+	}
+	existingNames := make(map[string]struct{}, len(*existing))
+	for _, cmd := range *existing {
+		existingNames[cmd.CommandName] = struct{}{}
+	}
+
+	importedNames := make(map[string]struct{})
+	for _, exported := range export.Commands {
+		importedNames[strings.ToLower(exported.Name)] = struct{}{}
+	}
+
+	cmds := make([]db.BotCommand, 0, len(export.Commands))
+	usedNames := make(map[string]struct{})
+	for _, exported := range export.Commands {
+		cmd, err := b.importedCommand(exported)
+		if err != nil {
+			return 0, err
+		}
+
+		for _, name := range append([]string{cmd.CommandName}, cmd.Aliases...) {
+			if _, ok := usedNames[name]; ok {
+				return 0, &CommandImportError{Command: cmd.CommandName, Reason: "name " + name + " is used more than once"}
+			}
+			usedNames[name] = struct{}{}
+		}
+		// Aliases can not shadow commands of the channel that are kept as they are
+		for _, alias := range cmd.Aliases {
+			_, exists := existingNames[alias]
+			_, imported := importedNames[alias]
+			if exists && !imported {
+				return 0, &CommandImportError{Command: cmd.CommandName, Reason: "alias " + alias + " is already a command"}
+			}
+		}
+
+		cmds = append(cmds, *cmd)
+	}
+
+	err = b.mainDB.ImportCommands(ctx, channelID, cmds, importedBy)
+	if err != nil {
+		return 0, err
+	}
+
+	b.invalidateChannelCommands(ctx, channelID)
+
+	return len(cmds), nil
+}
+
+// importedCommand validates a command of an import the same way !addcom and !editcom do
+func (b *bot) importedCommand(exported ExportedCommand) (*db.BotCommand, error) {
+	name := strings.ToLower(exported.Name)
+	if err := b.validateImportedName(name); err != nil {
+		return nil, &CommandImportError{Command: exported.Name, Reason: err.Error()}
+	}
+
+	cmd := db.BotCommand{
+		CommandName:            name,
+		CommandCooldownSeconds: exported.Cooldown,
+		UserCooldownSeconds:    exported.UserCooldown,
+		CooldownFeedback:       db.CooldownFeedback(strings.ToLower(exported.Feedback)),
+		MessageFormat:          exported.Format,
+		FormatVersion:          db.FormatVersion(exported.FormatVersion),
+		TwitchResponseType:     db.TwitchResponseType(strings.ToLower(exported.Action)),
+		CommandType:            db.CommandType(strings.ToLower(exported.Type)),
+		PermissionLevel:        db.PermissionLevel(strings.ToLower(exported.Permission)),
+		Enabled:                exported.Enabled == nil || *exported.Enabled,
+	}
+	if cmd.CommandCooldownSeconds == 0 {
+		cmd.CommandCooldownSeconds = addcomDefaultCooldown
+	}
+	if cmd.CooldownFeedback == "" {
+		cmd.CooldownFeedback = addcomDefaultFeedback
+	}
+	if cmd.MessageFormat == "" {
+		cmd.MessageFormat = addcomDefaultFormat
+	}
+	if cmd.FormatVersion == 0 {
+		cmd.FormatVersion = addcomDefaultFormatVersion
+	}
+	if cmd.TwitchResponseType == "" {
+		cmd.TwitchResponseType = addcomDefaultResponseType
+	}
+	if cmd.CommandType == "" {
+		cmd.CommandType = addcomDefaultCommandType
+	}
+	if cmd.PermissionLevel == "" {
+		cmd.PermissionLevel = addcomDefaultPermission
+	}
+
+	invalid := func(reason string) (*db.BotCommand, error) {
+		return nil, &CommandImportError{Command: name, Reason: reason}
+	}
+
+	for _, alias := range exported.Aliases {
+		alias = strings.ToLower(alias)
+		if err := b.validateImportedName(alias); err != nil {
+			return invalid("alias " + alias + ": " + err.Error())
+		}
+		cmd.Aliases = append(cmd.Aliases, alias)
+	}
+
+	if len(exported.Accounts) == 0 || len(exported.Accounts) > commandMaxAccounts {
+		return invalid(fmt.Sprintf("commands need between 1 and %d accounts", commandMaxAccounts))
+	}
+	for _, account := range exported.Accounts {
+		platform := strings.ToLower(account.Platform)
+		if _, ok := db.AllPlatforms[platform]; !ok {
+			return invalid("invalid platform " + account.Platform)
+		}
+		if strings.TrimSpace(account.Username) == "" {
+			return invalid("accounts need a username")
+		}
+		cmd.RLAccounts = append(cmd.RLAccounts, db.RLAccount{Platform: db.RLPlatform(platform), Username: account.Username})
+	}
+
+	switch cmd.TwitchResponseType {
+	case db.TwitchResponseTypeMessage, db.TwitchResponseTypeReply, db.TwitchResponseTypeMention:
+	default:
+		return invalid("invalid action " + exported.Action)
+	}
+	switch cmd.CommandType {
+	case db.CommandTypeRank, db.CommandTypeCompare:
+	default:
+		return invalid("invalid type " + exported.Type)
+	}
+	switch cmd.CooldownFeedback {
+	case db.CooldownFeedbackSilent, db.CooldownFeedbackWhisper, db.CooldownFeedbackReply:
+	default:
+		return invalid("invalid feedback " + exported.Feedback)
+	}
+	if _, ok := db.AllPermissionLevels[string(cmd.PermissionLevel)]; !ok {
+		return invalid("invalid permission " + exported.Permission)
+	}
+	if cmd.CommandCooldownSeconds < commandMinCooldown {
+		return invalid(fmt.Sprintf("the minimum cooldown is %d seconds", commandMinCooldown))
+	}
+	if cmd.UserCooldownSeconds < 0 {
+		return invalid("the user cooldown can not be negative")
+	}
+	if cmd.FormatVersion != db.FormatVersionTokens && cmd.FormatVersion != db.FormatVersionTemplate {
+		return invalid(fmt.Sprintf("invalid format version %d", exported.FormatVersion))
+	}
+
+	err := validateFormat(cmd.MessageFormat, cmd.FormatVersion, len(cmd.RLAccounts))
+	if err != nil {
+		return invalid("invalid format: " + err.Error())
+	}
+
+	return &cmd, nil
+}
+
+func (b *bot) validateImportedName(name string) error {
+	if name == "" || len(name) > commandNameMaxLength || strings.ContainsFunc(name, unicode.IsSpace) {
+		return fmt.Errorf("names need between 1 and %d characters without whitespace", commandNameMaxLength)
+	}
+	if _, ok := b.configCommands[name]; ok {
+		return errors.New("name is used by a built-in command")
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package bot
+
+import "context"
+
+const defaultLanguage = "en"
+
+// messageKey identifies a message of a built-in command. Messages are looked up by key, so text that is not a built-in
+// message, like the output of custom commands, is never translated.
+type messageKey string
+
+// translations holds the messages of built-in commands by language. A message without a translation is sent in
+// English.
+var translations = map[string]map[messageKey]string{
+	defaultLanguage: messagesEN,
+	"de":            messagesDE,
+	"es":            messagesES,
+	"fr":            messagesFR,
+}
+
+// withLanguage returns a context in which messages are sent in the given language
+func withLanguage(ctx context.Context, language string) context.Context {
+	return context.WithValue(ctx, "language", language)
+}
+
+// contextLanguage returns the language messages are sent in, which is English unless the context says otherwise
+func contextLanguage(ctx context.Context) string {
+	if language, ok := ctx.Value("language").(string); ok && len(language) > 0 {
+		return language
+	}
+	return defaultLanguage
+}
+
+// localize returns a message in the language of the context
+func localize(ctx context.Context, key messageKey) string {
+	if translated, ok := translations[contextLanguage(ctx)][key]; ok {
+		return translated
+	}
+	return messagesEN[key]
+}
@@ -3,22 +3,39 @@ package bot
 import (
 	"context"
 	"fmt"
-	"text/template"
+	"unicode/utf8"
 )
 
+const twitchMaxMessageLength = 500
+
 const (
-	messageRateLimited         = "Player rank could not be fetched due to rate limiting. Please try again later."
-	messageBroadcasterOnly     = "This command can only be executed by the broadcaster."
-	messageChannelNameUpdate   = "Your name has changed, the bot has joined your channel under the new name. All commands were transferred."
-	messageBotNotJoined        = "The bot is not in your channel."
-	messageInvalidPlatform     = "Invalid platform, please use one of the following: epic, steam, ps, xbox."
-	messageCommandDoesNotExist = "Command could not be found."
+	messageRateLimited         messageKey = "rateLimited"
+	messageBroadcasterOnly     messageKey = "broadcasterOnly"
+	messageChannelNameUpdate   messageKey = "channelNameUpdate"
+	messageBotNotJoined        messageKey = "botNotJoined"
+	messageInvalidPlatform     messageKey = "invalidPlatform"
+	messageCommandDoesNotExist messageKey = "commandDoesNotExist"
+	messageFormatError         messageKey = "formatError"
+	messageInvalidFormat       messageKey = "invalidFormat"
+	messagePlayerNotFound      messageKey = "playerNotFound"
+	messageInternalError       messageKey = "internalError"
 )
 
-var (
-	templateMessageNotFound = template.Must(template.New("playerNotFoundTemplate").Parse("Player {{ .PlayerName }} could not be found on {{ .PlayerPlatform }}."))
-)
+// splitMessage splits text into parts that each fit into a single chat message
+func splitMessage(text string, maxLength int) []string {
+	var parts []string
+	for len(text) > maxLength {
+		end := maxLength
+		// do not cut multibyte characters in half
+		for end > 0 && !utf8.RuneStart(text[end]) {
+			end--
+		}
+		parts = append(parts, text[:end])
+		text = text[end:]
+	}
+	return append(parts, text)
+}
 
 func getMessageInternalErrorWithCtx(ctx context.Context) string {
-	return fmt.Sprintf("Internal error occurred while executing the command. Please try again later and reach out if the issue persists (Trace-ID %v).", ctx.Value("trace-id"))
+	return fmt.Sprintf(localize(ctx, messageInternalError), ctx.Value("trace-id"))
 }
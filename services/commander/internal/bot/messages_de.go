@@ -0,0 +1,153 @@
+package bot
+
+var messagesDE = map[messageKey]string{
+	messageRateLimited:         "Der Rang konnte wegen einer Ratenbegrenzung nicht abgerufen werden. Bitte versuche es später erneut.",
+	messageBroadcasterOnly:     "Dieser Befehl kann nur vom Streamer ausgeführt werden.",
+	messageChannelNameUpdate:   "Dein Name hat sich geändert, der Bot ist deinem Kanal unter dem neuen Namen beigetreten. Alle Befehle wurden übernommen.",
+	messageBotNotJoined:        "Der Bot ist nicht in deinem Kanal.",
+	messageInvalidPlatform:     "Ungültige Plattform, bitte verwende eine der folgenden: epic, steam, ps, xbox.",
+	messageCommandDoesNotExist: "Der Befehl wurde nicht gefunden.",
+	messageFormatError:         "Das Format dieses Befehls ist ungültig: ",
+	messageInvalidFormat:       "Ungültiges Format: ",
+	messagePlayerNotFound:      "Der Spieler %s wurde auf %s nicht gefunden.",
+	messageInternalError:       "Beim Ausführen des Befehls ist ein interner Fehler aufgetreten. Bitte versuche es später erneut und melde dich, falls das Problem bestehen bleibt (Trace-ID %v).",
+	messageCommandOnCooldown:   "%s ist noch %d Sekunden im Cooldown.",
+
+	messageAlreadyJoined:  "Der Bot ist deinem Kanal bereits beigetreten. Mit !addcom kannst du einen Rang-Befehl hinzufügen.",
+	messageReAuthRequired: "Der Bot ist für deinen Kanal bereits eingerichtet, hat aber keine Berechtigung, Nachrichten in deinem Kanal zu senden. Bitte authentifiziere dich erneut: ",
+	messageJoinAuth:       "Damit der Bot deinem Kanal beitreten kann, authentifiziere dich bitte hier: ",
+	messageBotLeft:        "Der Bot hat deinen Kanal verlassen.",
+
+	messageAddcomUsage:      "Ungültige Argumente. Verwendung: !addcom [Befehl] [Plattform] [Benutzername]",
+	messageCommandNameTaken: "Dieser Befehlsname wird bereits verwendet.",
+	messageCommandAdded:     "Befehl erfolgreich hinzugefügt!",
+	messageDelcomUsage:      "Ungültige Argumente. Verwendung: !delcom [Befehl]",
+	messageCommandDeleted:   "Befehl erfolgreich gelöscht.",
+	messageDelcomAlias:      "Dieser Befehl ist ein Alias. Verwende !unalias, um ihn zu entfernen.",
+
+	messageEditcomUsage:              "Ungültige Argumente. Verwendung: !editcom [Befehl] [account/addaccount/removeaccount/action/cooldown/usercooldown/feedback/format/formatversion/type/permission/enabled] [Werte...]",
+	messageEditcomAccountUsage:       "Ungültige Argumente. Verwendung: !editcom [Befehl] account [Plattform] [Benutzername]",
+	messageEditcomAddAccountUsage:    "Ungültige Argumente. Verwendung: !editcom [Befehl] addaccount [Plattform] [Benutzername]",
+	messageEditcomRemoveAccountUsage: "Ungültige Argumente. Verwendung: !editcom [Befehl] removeaccount [Account-Nummer]",
+	messageEditcomActionUsage:        "Ungültige Argumente. Verwendung: !editcom [Befehl] action [Antwortart]",
+	messageEditcomCooldownUsage:      "Ungültige Argumente. Verwendung: !editcom [Befehl] cooldown [Sekunden]",
+	messageEditcomTypeUsage:          "Ungültige Argumente. Verwendung: !editcom [Befehl] type [rank/compare]",
+	messageEditcomPermissionUsage:    "Ungültige Argumente. Verwendung: !editcom [Befehl] permission [everyone/subscriber/vip/moderator/broadcaster]",
+	messageEditcomUserCooldownUsage:  "Ungültige Argumente. Verwendung: !editcom [Befehl] usercooldown [Sekunden, 0 zum Deaktivieren]",
+	messageEditcomFeedbackUsage:      "Ungültige Argumente. Verwendung: !editcom [Befehl] feedback [silent/whisper/reply]",
+	messageEditcomFormatVersionUsage: "Ungültige Argumente. Verwendung: !editcom [Befehl] formatversion [1/2]",
+	messageEditcomEnabledUsage:       "Ungültige Argumente. Verwendung: !editcom [Befehl] enabled [on/off]",
+	messageCommandUpdated:            "Befehl erfolgreich aktualisiert!",
+	messageAddcomInvalidProperty:     "Ungültige Eigenschaft. Verfügbare Eigenschaften: account, addaccount, removeaccount, action, cooldown, usercooldown, feedback, format, formatversion, type, permission, enabled",
+	messageInvalidReplyAction:        "Ungültige Antwortart. Verfügbare Antwortarten: message, reply, mention",
+	messageMinCooldown:               "Der minimale Cooldown für Befehle beträgt 5 Sekunden.",
+	messageMaxAccounts:               "Befehle können nicht mehr als 4 Accounts verwenden.",
+	messageLastAccount:               "Der letzte Account eines Befehls kann nicht entfernt werden.",
+
+	messageNoCommandsConfigured: "Für diesen Kanal sind keine Befehle eingerichtet.",
+	messageCommandList:          "Befehle in diesem Kanal: ",
+	messageShowcomUsage:         "Ungültige Argumente. Verwendung: !showcom [Befehl]",
+	messageShowcomAliases:       "Aliasse: ",
+	messageShowcomType:          "Typ: ",
+	messageShowcomAccounts:      "Accounts: ",
+	messageShowcomAction:        "Aktion: ",
+	messageShowcomPermission:    "Berechtigung: ",
+	messageShowcomCooldown:      "Cooldown: ",
+	messageShowcomUserCooldown:  "Nutzer-Cooldown: ",
+	messageShowcomFeedback:      "Feedback: ",
+	messageShowcomFormatVersion: "Formatversion: ",
+	messageShowcomEnabled:       "Aktiviert: ",
+	messageShowcomFormat:        "Format: ",
+	messageShowcomNone:          "keine",
+	messageShowcomOn:            "an",
+	messageShowcomOff:           "aus",
+	messageTestformatUsage:      "Ungültige Argumente. Verwendung: !testformat [!Befehl oder v2 (optional)] [Format]",
+
+	messageAliasUsage:   "Ungültige Argumente. Verwendung: !alias [neuer Name] [bestehender Befehl]",
+	messageUnaliasUsage: "Ungültige Argumente. Verwendung: !unalias [Alias]",
+	messageAliasAdded:   "Alias erfolgreich hinzugefügt!",
+	messageAliasDeleted: "Alias erfolgreich gelöscht.",
+	messageNotAnAlias:   "Dieser Befehl ist kein Alias.",
+
+	messageLookupUsage:        "Ungültige Argumente. Verwendung: !lookup [on/off/format] [Werte...]",
+	messageLookupEnabled:      "Der !rank-Befehl zum Nachschlagen ist in deinem Kanal jetzt aktiviert.",
+	messageLookupDisabled:     "Der !rank-Befehl zum Nachschlagen ist in deinem Kanal jetzt deaktiviert.",
+	messageLookupFormatUpdate: "Das Format von !rank wurde erfolgreich aktualisiert!",
+
+	messageAnnounceUsage:          "Ungültige Argumente. Verwendung: !announce [on/off/format] [Werte...]",
+	messageAnnounceEnabled:        "Rang-Ankündigungen sind in deinem Kanal jetzt aktiviert, solange du live bist.",
+	messageAnnounceDisabled:       "Rang-Ankündigungen sind in deinem Kanal jetzt deaktiviert.",
+	messageAnnounceFormatUpdate:   "Das Format der Rang-Ankündigungen wurde erfolgreich aktualisiert! Verfügbare Tokens: $(name), $(change), $(rank), $(playlist)",
+	rankAnnouncementDefaultFormat: "$(name) ist in $(playlist) auf $(rank) $(change)!",
+	rankAnnouncementChangeUp:      "aufgestiegen",
+	rankAnnouncementChangeDown:    "abgestiegen",
+
+	messageRankemoteUsage:       "Ungültige Argumente. Verwendung: !rankemote [set/remove/list/clear] [Rang] [Emote]",
+	messageRankemoteSetUsage:    "Ungültige Argumente. Verwendung: !rankemote set [Rang, z.B. GC1] [Emote]",
+	messageRankemoteRemoveUsage: "Ungültige Argumente. Verwendung: !rankemote remove [Rang, z.B. GC1]",
+	messageInvalidRank:          "Ungültiger Rang, bitte verwende einen kurzen Rangnamen wie B1, C3, GC1 oder SSL.",
+	messageRankemoteSet:         "Rang-Emote gesetzt! Verwende den Modifikator e in Formaten, um es anzuzeigen, z.B. $(2.r.e)",
+	messageRankemoteRemoved:     "Rang-Emote entfernt.",
+	messageRankemotesCleared:    "Alle Rang-Emotes entfernt.",
+	messageNoRankemotes:         "Für diesen Kanal sind keine Rang-Emotes eingerichtet.",
+	messageRankemoteList:        "Rang-Emotes: ",
+
+	messageSetlangUsage:     "Ungültige Argumente. Verwendung: !setlang [en/de/es/fr]",
+	messageLanguageUpdated:  "Der Bot antwortet in deinem Kanal jetzt auf Deutsch.",
+	messageLanguageNotFound: "Nicht unterstützte Sprache. Verfügbare Sprachen: en, de, es, fr",
+
+	messageRankUsage:           "Ungültige Argumente. Verwendung: !rank [Plattform] [Benutzername]",
+	messageCompareUsage:        "Ungültige Argumente. Verwendung: !compare [Plattform] [Benutzername] vs [Plattform] [Benutzername]",
+	messageCompareCommandUsage: "Ungültige Argumente. Verwendung: %s%s [Plattform] [Benutzername]",
+	messageLinkrlUsage:         "Ungültige Argumente. Verwendung: !linkrl [Plattform] [Benutzername]",
+	messageAccountLinked:       "Dein Rocket League Account wurde verknüpft! Mit !myrank kannst du deine Ränge anzeigen.",
+	messageMaxViewerAccounts:   "Du kannst nicht mehr als 4 Rocket League Accounts verknüpfen.",
+	messageAccountsUnlinked:    "Alle deine verknüpften Rocket League Accounts wurden entfernt.",
+	messageNoAccountsLinked:    "Du hast noch keinen Rocket League Account verknüpft. Verwende !linkrl [Plattform] [Benutzername], um einen zu verknüpfen.",
+
+	messageEnterlbUsage:       "Ungültige Argumente. Verwendung: !enterlb [Plattform] [Benutzername]",
+	messageLeaderboardUsage:   "Ungültige Argumente. Verwendung: !leaderboard [Playlist]",
+	messageLeaderboardEntered: "Du bist der Bestenliste dieses Kanals beigetreten! Verwende !enterlb erneut, um deinen Rang zu aktualisieren, Ränge älter als eine Woche werden nicht angezeigt.",
+	messageLeaderboardLeft:    "Du hast die Bestenliste dieses Kanals verlassen.",
+	messageInvalidPlaylist:    "Ungültige Playlist, bitte verwende eine der folgenden: 1v1, 2v2, 3v3, 4v4, hoops, rumble, dropshot, snowday, tournaments, heatseeker.",
+	messageLeaderboardEmpty:   "Niemand hat für diese Playlist einen Rang aus der letzten Woche in der Bestenliste. Verwende !enterlb [Plattform] [Benutzername], um beizutreten oder deinen Rang zu aktualisieren.",
+	messageLeaderboardHeader:  "Bestenliste %s: ",
+
+	messagePrefixUsage:   "Ungültige Argumente. Verwendung: !prefix [neues Präfix/reset]",
+	messageInvalidPrefix: "Ungültiges Präfix. Präfixe können höchstens 5 Zeichen lang sein und nicht mit einem Buchstaben, einer Ziffer, /, . oder @ beginnen.",
+	messagePrefixUpdated: "Das Befehlspräfix deines Kanals wurde aktualisiert! Es gilt auch für die Befehle des Bots.",
+	messagePrefixReset:   "Dein Kanal verwendet wieder das Standard-Befehlspräfix.",
+
+	messageTriggerUsage:       "Ungültige Argumente. Verwendung: !trigger [add/remove/list] [Schlüsselwort] [Befehl]",
+	messageTriggerAddUsage:    "Ungültige Argumente. Verwendung: !trigger add [Schlüsselwort] [Befehl]",
+	messageTriggerRemoveUsage: "Ungültige Argumente. Verwendung: !trigger remove [Schlüsselwort]",
+	messageInvalidKeyword:     "Ungültiges Schlüsselwort, Schlüsselwörter müssen zwischen 3 und 100 Zeichen lang sein.",
+	messageMaxTriggers:        "Kanäle können nicht mehr als 20 Schlüsselwort-Trigger haben.",
+	messageTriggerAdded:       "Schlüsselwort-Trigger hinzugefügt! Der Befehl wird jetzt ausgeführt, sobald eine Chatnachricht das Schlüsselwort enthält.",
+	messageTriggerRemoved:     "Schlüsselwort-Trigger entfernt.",
+	messageTriggerNotFound:    "Dieser Schlüsselwort-Trigger existiert nicht.",
+	messageNoTriggers:         "Für diesen Kanal sind keine Schlüsselwort-Trigger eingerichtet.",
+	messageTriggerList:        "Schlüsselwort-Trigger: ",
+
+	messageUndocomUsage:  "Ungültige Argumente. Verwendung: !undocom [Befehl]",
+	messageNothingToUndo: "Für diesen Befehl gibt es keine Änderungen mehr, die rückgängig gemacht werden können.",
+	messageUndoAdd:       "Das Erstellen von %s%s wurde rückgängig gemacht, der Befehl wurde entfernt.",
+	messageUndoEdit:      "Die letzte Bearbeitung von %s%s durch %s wurde rückgängig gemacht.",
+	messageUndoDelete:    "Das Löschen von %s%s wurde rückgängig gemacht, der Befehl wurde wiederhergestellt.",
+
+	messageHistorycomUsage:      "Ungültige Argumente. Verwendung: !historycom [Befehl]",
+	messageNoCommandHistory:     "Für diesen Befehl sind keine Änderungen aufgezeichnet.",
+	messageCommandHistory:       "Änderungen von %s%s: ",
+	messageHistoryItemAdd:       "v%d hinzugefügt von %s am %s",
+	messageHistoryItemEdit:      "v%d %s geändert von %s am %s",
+	messageHistoryItemDelete:    "v%d gelöscht von %s am %s",
+	messageHistoryItemUnchanged: "v%d ohne Änderungen bearbeitet von %s am %s",
+	messageHistoryItemUndone:    " (rückgängig gemacht von %s)",
+
+	messageCommandsPaused:  "Alle eigenen Befehle dieses Kanals sind pausiert. Verwende !resume, um sie wieder zu aktivieren.",
+	messageCommandsResumed: "Alle eigenen Befehle dieses Kanals sind wieder aktiv.",
+
+	messageAPIKeyWhisper: "Dein API-Schlüssel für den Kanal %s ist %s - halte ihn geheim. Er ersetzt jeden vorherigen Schlüssel des Kanals.",
+	messageAPIKeySent:    "Ein neuer API-Schlüssel wurde dir per Flüstern geschickt. Der vorherige Schlüssel dieses Kanals funktioniert nicht mehr.",
+	messageAPIKeyFailed:  "Der API-Schlüssel konnte dir nicht zugeflüstert werden. Bitte stelle sicher, dass du Flüsternachrichten empfangen kannst, und versuche es erneut.",
+}
@@ -0,0 +1,153 @@
+package bot
+
+var messagesEN = map[messageKey]string{
+	messageRateLimited:         "Player rank could not be fetched due to rate limiting. Please try again later.",
+	messageBroadcasterOnly:     "This command can only be executed by the broadcaster.",
+	messageChannelNameUpdate:   "Your name has changed, the bot has joined your channel under the new name. All commands were transferred.",
+	messageBotNotJoined:        "The bot is not in your channel.",
+	messageInvalidPlatform:     "Invalid platform, please use one of the following: epic, steam, ps, xbox.",
+	messageCommandDoesNotExist: "Command could not be found.",
+	messageFormatError:         "The format of this command is invalid: ",
+	messageInvalidFormat:       "Invalid format: ",
+	messagePlayerNotFound:      "Player %s could not be found on %s.",
+	messageInternalError:       "Internal error occurred while executing the command. Please try again later and reach out if the issue persists (Trace-ID %v).",
+	messageCommandOnCooldown:   "%s is on cooldown for %d more seconds.",
+
+	messageAlreadyJoined:  "The bot has already joined your channel. You can add a rank command using !addcom.",
+	messageReAuthRequired: "The bot is already configured for your channel, but is missing permissions to send messages in your channel. Please reauthenticate: ",
+	messageJoinAuth:       "To allow the bot to join your channel please authenticate here: ",
+	messageBotLeft:        "The bot has left your channel.",
+
+	messageAddcomUsage:      "Unexpected Arguments. Usage: !addcom [command] [platform] [username]",
+	messageCommandNameTaken: "This command name is already in use.",
+	messageCommandAdded:     "Command successfully added!",
+	messageDelcomUsage:      "Unexpected Arguments. Usage: !delcom [command]",
+	messageCommandDeleted:   "Command successfully deleted.",
+	messageDelcomAlias:      "This command is an alias. Use !unalias to remove it.",
+
+	messageEditcomUsage:              "Unexpected Arguments. Usage: !editcom [command] [account/addaccount/removeaccount/action/cooldown/usercooldown/feedback/format/formatversion/type/permission/enabled] [values...]",
+	messageEditcomAccountUsage:       "Unexpected Arguments. Usage: !editcom [command] account [platform] [username]",
+	messageEditcomAddAccountUsage:    "Unexpected Arguments. Usage: !editcom [command] addaccount [platform] [username]",
+	messageEditcomRemoveAccountUsage: "Unexpected Arguments. Usage: !editcom [command] removeaccount [account number]",
+	messageEditcomActionUsage:        "Unexpected Arguments. Usage: !editcom [command] action [reply action]",
+	messageEditcomCooldownUsage:      "Unexpected Arguments. Usage: !editcom [command] cooldown [seconds]",
+	messageEditcomTypeUsage:          "Unexpected Arguments. Usage: !editcom [command] type [rank/compare]",
+	messageEditcomPermissionUsage:    "Unexpected Arguments. Usage: !editcom [command] permission [everyone/subscriber/vip/moderator/broadcaster]",
+	messageEditcomUserCooldownUsage:  "Unexpected Arguments. Usage: !editcom [command] usercooldown [seconds, 0 to disable]",
+	messageEditcomFeedbackUsage:      "Unexpected Arguments. Usage: !editcom [command] feedback [silent/whisper/reply]",
+	messageEditcomFormatVersionUsage: "Unexpected Arguments. Usage: !editcom [command] formatversion [1/2]",
+	messageEditcomEnabledUsage:       "Unexpected Arguments. Usage: !editcom [command] enabled [on/off]",
+	messageCommandUpdated:            "Updated command successfully!",
+	messageAddcomInvalidProperty:     "Invalid property. Available properties: account, addaccount, removeaccount, action, cooldown, usercooldown, feedback, format, formatversion, type, permission, enabled",
+	messageInvalidReplyAction:        "Invalid reply action. Available actions: message, reply, mention",
+	messageMinCooldown:               "The minimum cooldown for commands is 5 seconds.",
+	messageMaxAccounts:               "Commands can not use more than 4 accounts.",
+	messageLastAccount:               "The last account of a command can not be removed.",
+
+	messageNoCommandsConfigured: "No commands are configured for this channel.",
+	messageCommandList:          "Commands in this channel: ",
+	messageShowcomUsage:         "Unexpected Arguments. Usage: !showcom [command]",
+	messageShowcomAliases:       "Aliases: ",
+	messageShowcomType:          "Type: ",
+	messageShowcomAccounts:      "Accounts: ",
+	messageShowcomAction:        "Action: ",
+	messageShowcomPermission:    "Permission: ",
+	messageShowcomCooldown:      "Cooldown: ",
+	messageShowcomUserCooldown:  "User cooldown: ",
+	messageShowcomFeedback:      "Feedback: ",
+	messageShowcomFormatVersion: "Format version: ",
+	messageShowcomEnabled:       "Enabled: ",
+	messageShowcomFormat:        "Format: ",
+	messageShowcomNone:          "none",
+	messageShowcomOn:            "on",
+	messageShowcomOff:           "off",
+	messageTestformatUsage:      "Unexpected Arguments. Usage: !testformat [!command or v2 (optional)] [format]",
+
+	messageAliasUsage:   "Unexpected Arguments. Usage: !alias [new name] [existing command]",
+	messageUnaliasUsage: "Unexpected Arguments. Usage: !unalias [alias]",
+	messageAliasAdded:   "Alias successfully added!",
+	messageAliasDeleted: "Alias successfully deleted.",
+	messageNotAnAlias:   "This command is not an alias.",
+
+	messageLookupUsage:        "Unexpected Arguments. Usage: !lookup [on/off/format] [values...]",
+	messageLookupEnabled:      "The !rank lookup command is now enabled in your channel.",
+	messageLookupDisabled:     "The !rank lookup command is now disabled in your channel.",
+	messageLookupFormatUpdate: "Updated the !rank lookup format successfully!",
+
+	messageAnnounceUsage:          "Unexpected Arguments. Usage: !announce [on/off/format] [values...]",
+	messageAnnounceEnabled:        "Rank up announcements are now enabled in your channel while you are live.",
+	messageAnnounceDisabled:       "Rank up announcements are now disabled in your channel.",
+	messageAnnounceFormatUpdate:   "Updated the rank up announcement format successfully! Available tokens: $(name), $(change), $(rank), $(playlist)",
+	rankAnnouncementDefaultFormat: "$(name) $(change) to $(rank) in $(playlist)!",
+	rankAnnouncementChangeUp:      "ranked up",
+	rankAnnouncementChangeDown:    "ranked down",
+
+	messageRankemoteUsage:       "Unexpected Arguments. Usage: !rankemote [set/remove/list/clear] [rank] [emote]",
+	messageRankemoteSetUsage:    "Unexpected Arguments. Usage: !rankemote set [rank, e.g. GC1] [emote]",
+	messageRankemoteRemoveUsage: "Unexpected Arguments. Usage: !rankemote remove [rank, e.g. GC1]",
+	messageInvalidRank:          "Invalid rank, please use a short rank name like B1, C3, GC1 or SSL.",
+	messageRankemoteSet:         "Rank emote set! Use the e modifier in formats to show it, e.g. $(2.r.e)",
+	messageRankemoteRemoved:     "Rank emote removed.",
+	messageRankemotesCleared:    "All rank emotes removed.",
+	messageNoRankemotes:         "No rank emotes are configured for this channel.",
+	messageRankemoteList:        "Rank emotes: ",
+
+	messageSetlangUsage:     "Unexpected Arguments. Usage: !setlang [en/de/es/fr]",
+	messageLanguageUpdated:  "The bot will now reply in English in your channel.",
+	messageLanguageNotFound: "Unsupported language. Available languages: en, de, es, fr",
+
+	messageRankUsage:           "Unexpected Arguments. Usage: !rank [platform] [username]",
+	messageCompareUsage:        "Unexpected Arguments. Usage: !compare [platform] [username] vs [platform] [username]",
+	messageCompareCommandUsage: "Unexpected Arguments. Usage: %s%s [platform] [username]",
+	messageLinkrlUsage:         "Unexpected Arguments. Usage: !linkrl [platform] [username]",
+	messageAccountLinked:       "Your Rocket League account was linked! Use !myrank to show your ranks.",
+	messageMaxViewerAccounts:   "You can not link more than 4 Rocket League accounts.",
+	messageAccountsUnlinked:    "All of your linked Rocket League accounts were removed.",
+	messageNoAccountsLinked:    "You have not linked a Rocket League account yet. Use !linkrl [platform] [username] to link one.",
+
+	messageEnterlbUsage:       "Unexpected Arguments. Usage: !enterlb [platform] [username]",
+	messageLeaderboardUsage:   "Unexpected Arguments. Usage: !leaderboard [playlist]",
+	messageLeaderboardEntered: "You have entered the leaderboard of this channel! Use !enterlb again to refresh your rank, ranks older than a week are not shown.",
+	messageLeaderboardLeft:    "You have left the leaderboard of this channel.",
+	messageInvalidPlaylist:    "Invalid playlist, please use one of the following: 1v1, 2v2, 3v3, 4v4, hoops, rumble, dropshot, snowday, tournaments, heatseeker.",
+	messageLeaderboardEmpty:   "Nobody has a rank from the last week on the leaderboard for this playlist. Use !enterlb [platform] [username] to enter or refresh your rank.",
+	messageLeaderboardHeader:  "%s leaderboard: ",
+
+	messagePrefixUsage:   "Unexpected Arguments. Usage: !prefix [new prefix/reset]",
+	messageInvalidPrefix: "Invalid prefix. Prefixes can have at most 5 characters and can not start with a letter, a digit, /, . or @.",
+	messagePrefixUpdated: "Updated the command prefix of your channel! It applies to the commands of the bot as well.",
+	messagePrefixReset:   "Your channel uses the default command prefix again.",
+
+	messageTriggerUsage:       "Unexpected Arguments. Usage: !trigger [add/remove/list] [keyword] [command]",
+	messageTriggerAddUsage:    "Unexpected Arguments. Usage: !trigger add [keyword] [command]",
+	messageTriggerRemoveUsage: "Unexpected Arguments. Usage: !trigger remove [keyword]",
+	messageInvalidKeyword:     "Invalid keyword, keywords must be between 3 and 100 characters long.",
+	messageMaxTriggers:        "Channels can not have more than 20 keyword triggers.",
+	messageTriggerAdded:       "Keyword trigger added! The command is now executed whenever a chat message contains the keyword.",
+	messageTriggerRemoved:     "Keyword trigger removed.",
+	messageTriggerNotFound:    "This keyword trigger does not exist.",
+	messageNoTriggers:         "No keyword triggers are configured for this channel.",
+	messageTriggerList:        "Keyword triggers: ",
+
+	messageUndocomUsage:  "Unexpected Arguments. Usage: !undocom [command]",
+	messageNothingToUndo: "There are no changes of this command left to undo.",
+	messageUndoAdd:       "Undid the creation of %s%s, the command was removed.",
+	messageUndoEdit:      "Undid the last edit of %s%s by %s.",
+	messageUndoDelete:    "Undid the deletion of %s%s, the command was restored.",
+
+	messageHistorycomUsage:      "Unexpected Arguments. Usage: !historycom [command]",
+	messageNoCommandHistory:     "No changes are recorded for this command.",
+	messageCommandHistory:       "Changes of %s%s: ",
+	messageHistoryItemAdd:       "v%d added by %s on %s",
+	messageHistoryItemEdit:      "v%d %s changed by %s on %s",
+	messageHistoryItemDelete:    "v%d deleted by %s on %s",
+	messageHistoryItemUnchanged: "v%d edited without changes by %s on %s",
+	messageHistoryItemUndone:    " (undone by %s)",
+
+	messageCommandsPaused:  "All custom commands of this channel are paused. Use !resume to turn them back on.",
+	messageCommandsResumed: "All custom commands of this channel are active again.",
+
+	messageAPIKeyWhisper: "Your API key for the channel %s is %s - keep it secret. It replaces any previous key of the channel.",
+	messageAPIKeySent:    "A new API key was sent to you via whisper. The previous key of this channel no longer works.",
+	messageAPIKeyFailed:  "The API key could not be whispered to you. Please make sure you can receive whispers and try again.",
+}
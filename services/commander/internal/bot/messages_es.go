@@ -0,0 +1,153 @@
+package bot
+
+var messagesES = map[messageKey]string{
+	messageRateLimited:         "No se pudo obtener el rango del jugador por un límite de solicitudes. Inténtalo de nuevo más tarde.",
+	messageBroadcasterOnly:     "Este comando solo puede ejecutarlo el streamer.",
+	messageChannelNameUpdate:   "Tu nombre ha cambiado, el bot se ha unido a tu canal con el nuevo nombre. Todos los comandos se han transferido.",
+	messageBotNotJoined:        "El bot no está en tu canal.",
+	messageInvalidPlatform:     "Plataforma no válida, usa una de las siguientes: epic, steam, ps, xbox.",
+	messageCommandDoesNotExist: "No se encontró el comando.",
+	messageFormatError:         "El formato de este comando no es válido: ",
+	messageInvalidFormat:       "Formato no válido: ",
+	messagePlayerNotFound:      "No se encontró al jugador %s en %s.",
+	messageInternalError:       "Se produjo un error interno al ejecutar el comando. Inténtalo de nuevo más tarde y contáctanos si el problema persiste (Trace-ID %v).",
+	messageCommandOnCooldown:   "%s está en enfriamiento durante %d segundos más.",
+
+	messageAlreadyJoined:  "El bot ya se ha unido a tu canal. Puedes añadir un comando de rango con !addcom.",
+	messageReAuthRequired: "El bot ya está configurado para tu canal, pero no tiene permiso para enviar mensajes en tu canal. Vuelve a autenticarte: ",
+	messageJoinAuth:       "Para que el bot pueda unirse a tu canal, autentícate aquí: ",
+	messageBotLeft:        "El bot ha salido de tu canal.",
+
+	messageAddcomUsage:      "Argumentos inesperados. Uso: !addcom [comando] [plataforma] [usuario]",
+	messageCommandNameTaken: "Este nombre de comando ya está en uso.",
+	messageCommandAdded:     "¡Comando añadido correctamente!",
+	messageDelcomUsage:      "Argumentos inesperados. Uso: !delcom [comando]",
+	messageCommandDeleted:   "Comando eliminado correctamente.",
+	messageDelcomAlias:      "Este comando es un alias. Usa !unalias para eliminarlo.",
+
+	messageEditcomUsage:              "Argumentos inesperados. Uso: !editcom [comando] [account/addaccount/removeaccount/action/cooldown/usercooldown/feedback/format/formatversion/type/permission/enabled] [valores...]",
+	messageEditcomAccountUsage:       "Argumentos inesperados. Uso: !editcom [comando] account [plataforma] [usuario]",
+	messageEditcomAddAccountUsage:    "Argumentos inesperados. Uso: !editcom [comando] addaccount [plataforma] [usuario]",
+	messageEditcomRemoveAccountUsage: "Argumentos inesperados. Uso: !editcom [comando] removeaccount [número de cuenta]",
+	messageEditcomActionUsage:        "Argumentos inesperados. Uso: !editcom [comando] action [tipo de respuesta]",
+	messageEditcomCooldownUsage:      "Argumentos inesperados. Uso: !editcom [comando] cooldown [segundos]",
+	messageEditcomTypeUsage:          "Argumentos inesperados. Uso: !editcom [comando] type [rank/compare]",
+	messageEditcomPermissionUsage:    "Argumentos inesperados. Uso: !editcom [comando] permission [everyone/subscriber/vip/moderator/broadcaster]",
+	messageEditcomUserCooldownUsage:  "Argumentos inesperados. Uso: !editcom [comando] usercooldown [segundos, 0 para desactivar]",
+	messageEditcomFeedbackUsage:      "Argumentos inesperados. Uso: !editcom [comando] feedback [silent/whisper/reply]",
+	messageEditcomFormatVersionUsage: "Argumentos inesperados. Uso: !editcom [comando] formatversion [1/2]",
+	messageEditcomEnabledUsage:       "Argumentos inesperados. Uso: !editcom [comando] enabled [on/off]",
+	messageCommandUpdated:            "¡Comando actualizado correctamente!",
+	messageAddcomInvalidProperty:     "Propiedad no válida. Propiedades disponibles: account, addaccount, removeaccount, action, cooldown, usercooldown, feedback, format, formatversion, type, permission, enabled",
+	messageInvalidReplyAction:        "Tipo de respuesta no válido. Tipos disponibles: message, reply, mention",
+	messageMinCooldown:               "El enfriamiento mínimo de los comandos es de 5 segundos.",
+	messageMaxAccounts:               "Los comandos no pueden usar más de 4 cuentas.",
+	messageLastAccount:               "No se puede eliminar la última cuenta de un comando.",
+
+	messageNoCommandsConfigured: "No hay comandos configurados en este canal.",
+	messageCommandList:          "Comandos de este canal: ",
+	messageShowcomUsage:         "Argumentos inesperados. Uso: !showcom [comando]",
+	messageShowcomAliases:       "Alias: ",
+	messageShowcomType:          "Tipo: ",
+	messageShowcomAccounts:      "Cuentas: ",
+	messageShowcomAction:        "Acción: ",
+	messageShowcomPermission:    "Permiso: ",
+	messageShowcomCooldown:      "Cooldown: ",
+	messageShowcomUserCooldown:  "Cooldown por usuario: ",
+	messageShowcomFeedback:      "Aviso: ",
+	messageShowcomFormatVersion: "Versión de formato: ",
+	messageShowcomEnabled:       "Activado: ",
+	messageShowcomFormat:        "Formato: ",
+	messageShowcomNone:          "ninguno",
+	messageShowcomOn:            "activado",
+	messageShowcomOff:           "desactivado",
+	messageTestformatUsage:      "Argumentos inesperados. Uso: !testformat [!comando o v2 (opcional)] [formato]",
+
+	messageAliasUsage:   "Argumentos inesperados. Uso: !alias [nuevo nombre] [comando existente]",
+	messageUnaliasUsage: "Argumentos inesperados. Uso: !unalias [alias]",
+	messageAliasAdded:   "¡Alias añadido correctamente!",
+	messageAliasDeleted: "Alias eliminado correctamente.",
+	messageNotAnAlias:   "Este comando no es un alias.",
+
+	messageLookupUsage:        "Argumentos inesperados. Uso: !lookup [on/off/format] [valores...]",
+	messageLookupEnabled:      "El comando de búsqueda !rank ya está activado en tu canal.",
+	messageLookupDisabled:     "El comando de búsqueda !rank ya está desactivado en tu canal.",
+	messageLookupFormatUpdate: "¡El formato de búsqueda de !rank se actualizó correctamente!",
+
+	messageAnnounceUsage:          "Argumentos inesperados. Uso: !announce [on/off/format] [valores...]",
+	messageAnnounceEnabled:        "Los anuncios de subida de rango ya están activados en tu canal mientras estés en directo.",
+	messageAnnounceDisabled:       "Los anuncios de subida de rango ya están desactivados en tu canal.",
+	messageAnnounceFormatUpdate:   "¡El formato de los anuncios de rango se actualizó correctamente! Tokens disponibles: $(name), $(change), $(rank), $(playlist)",
+	rankAnnouncementDefaultFormat: "¡$(name) $(change) a $(rank) en $(playlist)!",
+	rankAnnouncementChangeUp:      "subió",
+	rankAnnouncementChangeDown:    "bajó",
+
+	messageRankemoteUsage:       "Argumentos inesperados. Uso: !rankemote [set/remove/list/clear] [rango] [emote]",
+	messageRankemoteSetUsage:    "Argumentos inesperados. Uso: !rankemote set [rango, p. ej. GC1] [emote]",
+	messageRankemoteRemoveUsage: "Argumentos inesperados. Uso: !rankemote remove [rango, p. ej. GC1]",
+	messageInvalidRank:          "Rango no válido, usa un nombre de rango corto como B1, C3, GC1 o SSL.",
+	messageRankemoteSet:         "¡Emote de rango configurado! Usa el modificador e en los formatos para mostrarlo, p. ej. $(2.r.e)",
+	messageRankemoteRemoved:     "Emote de rango eliminado.",
+	messageRankemotesCleared:    "Se eliminaron todos los emotes de rango.",
+	messageNoRankemotes:         "No hay emotes de rango configurados en este canal.",
+	messageRankemoteList:        "Emotes de rango: ",
+
+	messageSetlangUsage:     "Argumentos inesperados. Uso: !setlang [en/de/es/fr]",
+	messageLanguageUpdated:  "A partir de ahora el bot responderá en español en tu canal.",
+	messageLanguageNotFound: "Idioma no compatible. Idiomas disponibles: en, de, es, fr",
+
+	messageRankUsage:           "Argumentos inesperados. Uso: !rank [plataforma] [usuario]",
+	messageCompareUsage:        "Argumentos inesperados. Uso: !compare [plataforma] [usuario] vs [plataforma] [usuario]",
+	messageCompareCommandUsage: "Argumentos inesperados. Uso: %s%s [plataforma] [usuario]",
+	messageLinkrlUsage:         "Argumentos inesperados. Uso: !linkrl [plataforma] [usuario]",
+	messageAccountLinked:       "¡Tu cuenta de Rocket League se ha vinculado! Usa !myrank para mostrar tus rangos.",
+	messageMaxViewerAccounts:   "No puedes vincular más de 4 cuentas de Rocket League.",
+	messageAccountsUnlinked:    "Se eliminaron todas tus cuentas de Rocket League vinculadas.",
+	messageNoAccountsLinked:    "Todavía no has vinculado ninguna cuenta de Rocket League. Usa !linkrl [plataforma] [usuario] para vincular una.",
+
+	messageEnterlbUsage:       "Argumentos inesperados. Uso: !enterlb [plataforma] [usuario]",
+	messageLeaderboardUsage:   "Argumentos inesperados. Uso: !leaderboard [playlist]",
+	messageLeaderboardEntered: "¡Has entrado en la clasificación de este canal! Usa !enterlb de nuevo para actualizar tu rango, los rangos de hace más de una semana no se muestran.",
+	messageLeaderboardLeft:    "Has salido de la clasificación de este canal.",
+	messageInvalidPlaylist:    "Playlist no válida, usa una de las siguientes: 1v1, 2v2, 3v3, 4v4, hoops, rumble, dropshot, snowday, tournaments, heatseeker.",
+	messageLeaderboardEmpty:   "Nadie tiene un rango de la última semana en la clasificación de esta playlist. Usa !enterlb [plataforma] [usuario] para entrar o actualizar tu rango.",
+	messageLeaderboardHeader:  "Clasificación de %s: ",
+
+	messagePrefixUsage:   "Argumentos inesperados. Uso: !prefix [nuevo prefijo/reset]",
+	messageInvalidPrefix: "Prefijo no válido. Los prefijos pueden tener como máximo 5 caracteres y no pueden empezar por una letra, un dígito, /, . o @.",
+	messagePrefixUpdated: "¡Se actualizó el prefijo de comandos de tu canal! También se aplica a los comandos del bot.",
+	messagePrefixReset:   "Tu canal vuelve a usar el prefijo de comandos predeterminado.",
+
+	messageTriggerUsage:       "Argumentos inesperados. Uso: !trigger [add/remove/list] [palabra clave] [comando]",
+	messageTriggerAddUsage:    "Argumentos inesperados. Uso: !trigger add [palabra clave] [comando]",
+	messageTriggerRemoveUsage: "Argumentos inesperados. Uso: !trigger remove [palabra clave]",
+	messageInvalidKeyword:     "Palabra clave no válida, las palabras clave deben tener entre 3 y 100 caracteres.",
+	messageMaxTriggers:        "Los canales no pueden tener más de 20 activadores por palabra clave.",
+	messageTriggerAdded:       "¡Activador por palabra clave añadido! El comando se ejecutará cada vez que un mensaje del chat contenga la palabra clave.",
+	messageTriggerRemoved:     "Activador por palabra clave eliminado.",
+	messageTriggerNotFound:    "Este activador por palabra clave no existe.",
+	messageNoTriggers:         "No hay activadores por palabra clave configurados en este canal.",
+	messageTriggerList:        "Activadores por palabra clave: ",
+
+	messageUndocomUsage:  "Argumentos inesperados. Uso: !undocom [comando]",
+	messageNothingToUndo: "No quedan cambios de este comando que deshacer.",
+	messageUndoAdd:       "Se deshizo la creación de %s%s, el comando fue eliminado.",
+	messageUndoEdit:      "Se deshizo la última edición de %s%s hecha por %s.",
+	messageUndoDelete:    "Se deshizo la eliminación de %s%s, el comando fue restaurado.",
+
+	messageHistorycomUsage:      "Argumentos inesperados. Uso: !historycom [comando]",
+	messageNoCommandHistory:     "No hay cambios registrados para este comando.",
+	messageCommandHistory:       "Cambios de %s%s: ",
+	messageHistoryItemAdd:       "v%d añadido por %s el %s",
+	messageHistoryItemEdit:      "v%d %s cambiado por %s el %s",
+	messageHistoryItemDelete:    "v%d eliminado por %s el %s",
+	messageHistoryItemUnchanged: "v%d editado sin cambios por %s el %s",
+	messageHistoryItemUndone:    " (deshecho por %s)",
+
+	messageCommandsPaused:  "Todos los comandos personalizados de este canal están en pausa. Usa !resume para volver a activarlos.",
+	messageCommandsResumed: "Todos los comandos personalizados de este canal vuelven a estar activos.",
+
+	messageAPIKeyWhisper: "Tu clave de API para el canal %s es %s - mantenla en secreto. Reemplaza cualquier clave anterior del canal.",
+	messageAPIKeySent:    "Se te ha enviado una nueva clave de API por susurro. La clave anterior de este canal ya no funciona.",
+	messageAPIKeyFailed:  "No se pudo enviarte la clave de API por susurro. Asegúrate de poder recibir susurros e inténtalo de nuevo.",
+}
@@ -0,0 +1,153 @@
+package bot
+
+var messagesFR = map[messageKey]string{
+	messageRateLimited:         "Le rang du joueur n'a pas pu être récupéré à cause d'une limite de requêtes. Merci de réessayer plus tard.",
+	messageBroadcasterOnly:     "Cette commande ne peut être exécutée que par le streamer.",
+	messageChannelNameUpdate:   "Ton nom a changé, le bot a rejoint ta chaîne sous le nouveau nom. Toutes les commandes ont été transférées.",
+	messageBotNotJoined:        "Le bot n'est pas dans ta chaîne.",
+	messageInvalidPlatform:     "Plateforme invalide, merci d'utiliser l'une des suivantes : epic, steam, ps, xbox.",
+	messageCommandDoesNotExist: "La commande est introuvable.",
+	messageFormatError:         "Le format de cette commande est invalide : ",
+	messageInvalidFormat:       "Format invalide : ",
+	messagePlayerNotFound:      "Le joueur %s est introuvable sur %s.",
+	messageInternalError:       "Une erreur interne s'est produite lors de l'exécution de la commande. Merci de réessayer plus tard et de nous contacter si le problème persiste (Trace-ID %v).",
+	messageCommandOnCooldown:   "%s est en cooldown pendant encore %d secondes.",
+
+	messageAlreadyJoined:  "Le bot a déjà rejoint ta chaîne. Tu peux ajouter une commande de rang avec !addcom.",
+	messageReAuthRequired: "Le bot est déjà configuré pour ta chaîne, mais n'a pas la permission d'envoyer des messages dans ta chaîne. Merci de te réauthentifier : ",
+	messageJoinAuth:       "Pour permettre au bot de rejoindre ta chaîne, merci de t'authentifier ici : ",
+	messageBotLeft:        "Le bot a quitté ta chaîne.",
+
+	messageAddcomUsage:      "Arguments inattendus. Utilisation : !addcom [commande] [plateforme] [pseudo]",
+	messageCommandNameTaken: "Ce nom de commande est déjà utilisé.",
+	messageCommandAdded:     "Commande ajoutée avec succès !",
+	messageDelcomUsage:      "Arguments inattendus. Utilisation : !delcom [commande]",
+	messageCommandDeleted:   "Commande supprimée avec succès.",
+	messageDelcomAlias:      "Cette commande est un alias. Utilise !unalias pour la supprimer.",
+
+	messageEditcomUsage:              "Arguments inattendus. Utilisation : !editcom [commande] [account/addaccount/removeaccount/action/cooldown/usercooldown/feedback/format/formatversion/type/permission/enabled] [valeurs...]",
+	messageEditcomAccountUsage:       "Arguments inattendus. Utilisation : !editcom [commande] account [plateforme] [pseudo]",
+	messageEditcomAddAccountUsage:    "Arguments inattendus. Utilisation : !editcom [commande] addaccount [plateforme] [pseudo]",
+	messageEditcomRemoveAccountUsage: "Arguments inattendus. Utilisation : !editcom [commande] removeaccount [numéro du compte]",
+	messageEditcomActionUsage:        "Arguments inattendus. Utilisation : !editcom [commande] action [type de réponse]",
+	messageEditcomCooldownUsage:      "Arguments inattendus. Utilisation : !editcom [commande] cooldown [secondes]",
+	messageEditcomTypeUsage:          "Arguments inattendus. Utilisation : !editcom [commande] type [rank/compare]",
+	messageEditcomPermissionUsage:    "Arguments inattendus. Utilisation : !editcom [commande] permission [everyone/subscriber/vip/moderator/broadcaster]",
+	messageEditcomUserCooldownUsage:  "Arguments inattendus. Utilisation : !editcom [commande] usercooldown [secondes, 0 pour désactiver]",
+	messageEditcomFeedbackUsage:      "Arguments inattendus. Utilisation : !editcom [commande] feedback [silent/whisper/reply]",
+	messageEditcomFormatVersionUsage: "Arguments inattendus. Utilisation : !editcom [commande] formatversion [1/2]",
+	messageEditcomEnabledUsage:       "Arguments inattendus. Utilisation : !editcom [commande] enabled [on/off]",
+	messageCommandUpdated:            "Commande mise à jour avec succès !",
+	messageAddcomInvalidProperty:     "Propriété invalide. Propriétés disponibles : account, addaccount, removeaccount, action, cooldown, usercooldown, feedback, format, formatversion, type, permission, enabled",
+	messageInvalidReplyAction:        "Type de réponse invalide. Types disponibles : message, reply, mention",
+	messageMinCooldown:               "Le cooldown minimum des commandes est de 5 secondes.",
+	messageMaxAccounts:               "Les commandes ne peuvent pas utiliser plus de 4 comptes.",
+	messageLastAccount:               "Le dernier compte d'une commande ne peut pas être supprimé.",
+
+	messageNoCommandsConfigured: "Aucune commande n'est configurée pour cette chaîne.",
+	messageCommandList:          "Commandes de cette chaîne : ",
+	messageShowcomUsage:         "Arguments inattendus. Utilisation : !showcom [commande]",
+	messageShowcomAliases:       "Alias : ",
+	messageShowcomType:          "Type : ",
+	messageShowcomAccounts:      "Comptes : ",
+	messageShowcomAction:        "Action : ",
+	messageShowcomPermission:    "Permission : ",
+	messageShowcomCooldown:      "Cooldown : ",
+	messageShowcomUserCooldown:  "Cooldown par utilisateur : ",
+	messageShowcomFeedback:      "Retour : ",
+	messageShowcomFormatVersion: "Version du format : ",
+	messageShowcomEnabled:       "Activée : ",
+	messageShowcomFormat:        "Format : ",
+	messageShowcomNone:          "aucun",
+	messageShowcomOn:            "activé",
+	messageShowcomOff:           "désactivé",
+	messageTestformatUsage:      "Arguments inattendus. Utilisation : !testformat [!commande ou v2 (facultatif)] [format]",
+
+	messageAliasUsage:   "Arguments inattendus. Utilisation : !alias [nouveau nom] [commande existante]",
+	messageUnaliasUsage: "Arguments inattendus. Utilisation : !unalias [alias]",
+	messageAliasAdded:   "Alias ajouté avec succès !",
+	messageAliasDeleted: "Alias supprimé avec succès.",
+	messageNotAnAlias:   "Cette commande n'est pas un alias.",
+
+	messageLookupUsage:        "Arguments inattendus. Utilisation : !lookup [on/off/format] [valeurs...]",
+	messageLookupEnabled:      "La commande de recherche !rank est maintenant activée dans ta chaîne.",
+	messageLookupDisabled:     "La commande de recherche !rank est maintenant désactivée dans ta chaîne.",
+	messageLookupFormatUpdate: "Le format de recherche de !rank a été mis à jour avec succès !",
+
+	messageAnnounceUsage:          "Arguments inattendus. Utilisation : !announce [on/off/format] [valeurs...]",
+	messageAnnounceEnabled:        "Les annonces de changement de rang sont maintenant activées dans ta chaîne pendant tes lives.",
+	messageAnnounceDisabled:       "Les annonces de changement de rang sont maintenant désactivées dans ta chaîne.",
+	messageAnnounceFormatUpdate:   "Le format des annonces de rang a été mis à jour avec succès ! Tokens disponibles : $(name), $(change), $(rank), $(playlist)",
+	rankAnnouncementDefaultFormat: "$(name) $(change) $(rank) en $(playlist) !",
+	rankAnnouncementChangeUp:      "est monté",
+	rankAnnouncementChangeDown:    "est descendu",
+
+	messageRankemoteUsage:       "Arguments inattendus. Utilisation : !rankemote [set/remove/list/clear] [rang] [emote]",
+	messageRankemoteSetUsage:    "Arguments inattendus. Utilisation : !rankemote set [rang, p. ex. GC1] [emote]",
+	messageRankemoteRemoveUsage: "Arguments inattendus. Utilisation : !rankemote remove [rang, p. ex. GC1]",
+	messageInvalidRank:          "Rang invalide, merci d'utiliser un nom de rang court comme B1, C3, GC1 ou SSL.",
+	messageRankemoteSet:         "Emote de rang défini ! Utilise le modificateur e dans les formats pour l'afficher, p. ex. $(2.r.e)",
+	messageRankemoteRemoved:     "Emote de rang supprimé.",
+	messageRankemotesCleared:    "Tous les emotes de rang ont été supprimés.",
+	messageNoRankemotes:         "Aucun emote de rang n'est configuré pour cette chaîne.",
+	messageRankemoteList:        "Emotes de rang : ",
+
+	messageSetlangUsage:     "Arguments inattendus. Utilisation : !setlang [en/de/es/fr]",
+	messageLanguageUpdated:  "Le bot répondra désormais en français dans ta chaîne.",
+	messageLanguageNotFound: "Langue non prise en charge. Langues disponibles : en, de, es, fr",
+
+	messageRankUsage:           "Arguments inattendus. Utilisation : !rank [plateforme] [pseudo]",
+	messageCompareUsage:        "Arguments inattendus. Utilisation : !compare [plateforme] [pseudo] vs [plateforme] [pseudo]",
+	messageCompareCommandUsage: "Arguments inattendus. Utilisation : %s%s [plateforme] [pseudo]",
+	messageLinkrlUsage:         "Arguments inattendus. Utilisation : !linkrl [plateforme] [pseudo]",
+	messageAccountLinked:       "Ton compte Rocket League a été lié ! Utilise !myrank pour afficher tes rangs.",
+	messageMaxViewerAccounts:   "Tu ne peux pas lier plus de 4 comptes Rocket League.",
+	messageAccountsUnlinked:    "Tous tes comptes Rocket League liés ont été supprimés.",
+	messageNoAccountsLinked:    "Tu n'as pas encore lié de compte Rocket League. Utilise !linkrl [plateforme] [pseudo] pour en lier un.",
+
+	messageEnterlbUsage:       "Arguments inattendus. Utilisation : !enterlb [plateforme] [pseudo]",
+	messageLeaderboardUsage:   "Arguments inattendus. Utilisation : !leaderboard [playlist]",
+	messageLeaderboardEntered: "Tu as rejoint le classement de cette chaîne ! Utilise à nouveau !enterlb pour actualiser ton rang, les rangs de plus d'une semaine ne sont pas affichés.",
+	messageLeaderboardLeft:    "Tu as quitté le classement de cette chaîne.",
+	messageInvalidPlaylist:    "Playlist invalide, merci d'utiliser l'une des suivantes : 1v1, 2v2, 3v3, 4v4, hoops, rumble, dropshot, snowday, tournaments, heatseeker.",
+	messageLeaderboardEmpty:   "Personne n'a de rang de la dernière semaine dans le classement de cette playlist. Utilise !enterlb [plateforme] [pseudo] pour le rejoindre ou actualiser ton rang.",
+	messageLeaderboardHeader:  "Classement %s : ",
+
+	messagePrefixUsage:   "Arguments inattendus. Utilisation : !prefix [nouveau préfixe/reset]",
+	messageInvalidPrefix: "Préfixe invalide. Les préfixes peuvent contenir au maximum 5 caractères et ne peuvent pas commencer par une lettre, un chiffre, /, . ou @.",
+	messagePrefixUpdated: "Le préfixe des commandes de ta chaîne a été mis à jour ! Il s'applique aussi aux commandes du bot.",
+	messagePrefixReset:   "Ta chaîne utilise à nouveau le préfixe de commandes par défaut.",
+
+	messageTriggerUsage:       "Arguments inattendus. Utilisation : !trigger [add/remove/list] [mot-clé] [commande]",
+	messageTriggerAddUsage:    "Arguments inattendus. Utilisation : !trigger add [mot-clé] [commande]",
+	messageTriggerRemoveUsage: "Arguments inattendus. Utilisation : !trigger remove [mot-clé]",
+	messageInvalidKeyword:     "Mot-clé invalide, les mots-clés doivent contenir entre 3 et 100 caractères.",
+	messageMaxTriggers:        "Les chaînes ne peuvent pas avoir plus de 20 déclencheurs par mot-clé.",
+	messageTriggerAdded:       "Déclencheur par mot-clé ajouté ! La commande est maintenant exécutée dès qu'un message du chat contient le mot-clé.",
+	messageTriggerRemoved:     "Déclencheur par mot-clé supprimé.",
+	messageTriggerNotFound:    "Ce déclencheur par mot-clé n'existe pas.",
+	messageNoTriggers:         "Aucun déclencheur par mot-clé n'est configuré pour cette chaîne.",
+	messageTriggerList:        "Déclencheurs par mot-clé : ",
+
+	messageUndocomUsage:  "Arguments inattendus. Utilisation : !undocom [commande]",
+	messageNothingToUndo: "Il ne reste aucune modification de cette commande à annuler.",
+	messageUndoAdd:       "La création de %s%s a été annulée, la commande a été supprimée.",
+	messageUndoEdit:      "La dernière modification de %s%s par %s a été annulée.",
+	messageUndoDelete:    "La suppression de %s%s a été annulée, la commande a été restaurée.",
+
+	messageHistorycomUsage:      "Arguments inattendus. Utilisation : !historycom [commande]",
+	messageNoCommandHistory:     "Aucune modification n'est enregistrée pour cette commande.",
+	messageCommandHistory:       "Modifications de %s%s : ",
+	messageHistoryItemAdd:       "v%d ajoutée par %s le %s",
+	messageHistoryItemEdit:      "v%d %s modifié par %s le %s",
+	messageHistoryItemDelete:    "v%d supprimée par %s le %s",
+	messageHistoryItemUnchanged: "v%d modifiée sans changement par %s le %s",
+	messageHistoryItemUndone:    " (annulée par %s)",
+
+	messageCommandsPaused:  "Toutes les commandes personnalisées de cette chaîne sont en pause. Utilise !resume pour les réactiver.",
+	messageCommandsResumed: "Toutes les commandes personnalisées de cette chaîne sont de nouveau actives.",
+
+	messageAPIKeyWhisper: "Ta clé API pour la chaîne %s est %s - garde-la secrète. Elle remplace toute clé précédente de la chaîne.",
+	messageAPIKeySent:    "Une nouvelle clé API t'a été envoyée en chuchotement. L'ancienne clé de cette chaîne ne fonctionne plus.",
+	messageAPIKeyFailed:  "La clé API n'a pas pu t'être chuchotée. Vérifie que tu peux recevoir des chuchotements et réessaie.",
+}
@@ -0,0 +1,145 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"RocketRankBot/services/commander/internal/formatter"
+	"RocketRankBot/services/commander/rpc/trackerggscraper"
+	"context"
+	"errors"
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+	"strings"
+	"time"
+)
+
+const (
+	rankAnnouncementDefaultFormat messageKey = "rankAnnouncementDefaultFormat"
+	rankAnnouncementChangeUp      messageKey = "rankAnnouncementChangeUp"
+	rankAnnouncementChangeDown    messageKey = "rankAnnouncementChangeDown"
+)
+
+const knownRanksTTL = time.Hour * 24
+
+// StartRankAnnouncer periodically checks the accounts of all live channels with announcements enabled and posts a
+// message when one of them crosses a division boundary. Only one instance polls per interval.
+func (b *bot) StartRankAnnouncer(ctx context.Context) {
+	if b.announceInterval <= 0 {
+		log.Ctx(ctx).Info().Msg("Rank announcements are disabled")
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(b.announceInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				b.pollRankAnnouncements(ctx)
+			}
+		}
+	}()
+}
+
+func (b *bot) pollRankAnnouncements(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, b.announceInterval)
+	defer cancel()
+
+	acquired, err := b.cacheDB.AcquireRateLimit(ctx, "rankannouncer", 1, b.announceInterval)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not acquire rank announcer lock")
+		return
+	}
+	if !acquired {
+		return
+	}
+
+	users, err := b.mainDB.FindRankAnnouncementUsers(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for rank announcement users")
+		return
+	}
+
+	for _, user := range users {
+		// Paused channels and disabled commands neither get announcements nor use scraper quota
+		if user.CommandsPaused {
+			continue
+		}
+
+		commands, err := b.mainDB.FindUserCommands(ctx, user.TwitchUserID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Could not query db for commands")
+			continue
+		}
+
+		checkedAccounts := make(map[db.RLAccount]struct{})
+		for _, cmd := range *commands {
+			if !cmd.Enabled {
+				continue
+			}
+			for _, account := range cmd.RLAccounts {
+				if _, ok := checkedAccounts[account]; ok {
+					continue
+				}
+				checkedAccounts[account] = struct{}{}
+
+				err = b.checkRankChanges(withLanguage(ctx, user.Language), &user, account)
+				if err != nil {
+					var twirpErr twirp.Error
+					if errors.As(err, &twirpErr) && twirpErr.Code() == twirp.ResourceExhausted {
+						log.Ctx(ctx).Info().Err(err).Msg("Rank service is rate limited, skipping remaining rank announcements")
+						return
+					}
+					log.Ctx(ctx).Warn().Err(err).Str("platform", string(account.Platform)).Str("username", account.Username).Msg("Could not check rank changes")
+				}
+			}
+		}
+	}
+}
+
+func (b *bot) checkRankChanges(ctx context.Context, user *db.BotUser, account db.RLAccount) error {
+	knownRanks, found, err := b.cacheDB.FindCachedKnownRanks(ctx, user.TwitchUserID, account)
+	if err != nil {
+		return err
+	}
+
+	rankRes, err := b.fetchRanks(ctx, account)
+	if err != nil {
+		return err
+	}
+
+	currentRanks := make(map[int32]db.KnownRank, len(rankRes.Ranks))
+	for _, ranking := range rankRes.Ranks {
+		current := db.KnownRank{Rank: int(ranking.Rank), Division: int(ranking.Division)}
+		currentRanks[int32(ranking.Playlist)] = current
+
+		// The first check of an account only records its ranks
+		known, ok := knownRanks[int32(ranking.Playlist)]
+		if !found || !ok || known == current {
+			continue
+		}
+
+		change := localize(ctx, rankAnnouncementChangeUp)
+		if current.Rank < known.Rank || (current.Rank == known.Rank && current.Division < known.Division) {
+			change = localize(ctx, rankAnnouncementChangeDown)
+		}
+
+		format := user.AnnounceFormat
+		if len(format) == 0 {
+			format = localize(ctx, rankAnnouncementDefaultFormat)
+		}
+		message := strings.NewReplacer(
+			"$(name)", rankRes.DisplayName,
+			"$(change)", change,
+			"$(rank)", formatter.RankName(current.Rank, current.Division, user.Language),
+			"$(playlist)", formatter.PlaylistName(trackerggscraper.RankPlaylist(ranking.Playlist), user.Language),
+		).Replace(format)
+
+		log.Ctx(ctx).Info().Str("channel-id", user.TwitchUserID).Str("platform", string(account.Platform)).Str("username", account.Username).Str("change", change).Msg("Announcing rank change")
+		b.sendTwitchMessage(ctx, user.TwitchUserID, message, nil)
+	}
+
+	return b.cacheDB.SetCachedKnownRanks(ctx, user.TwitchUserID, account, currentRanks, knownRanksTTL)
+}
@@ -0,0 +1,165 @@
+package bot
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"RocketRankBot/services/commander/internal/formatter"
+	"RocketRankBot/services/commander/rpc/trackerggscraper"
+	"context"
+	"errors"
+	"github.com/rs/zerolog/log"
+	"github.com/twitchtv/twirp"
+	"time"
+)
+
+// sessionMMRPerGame is the approximate MMR change of a single game, used to estimate wins and losses
+const sessionMMRPerGame = 9
+
+func (b *bot) StartStreamSession(ctx context.Context, channelID string, startedAt time.Time) {
+	ctx, cancel := context.WithTimeout(ctx, b.commandTimeout)
+	defer cancel()
+
+	log.Ctx(ctx).Info().Str("channel-id", channelID).Time("started-at", startedAt).Msg("Starting stream session")
+
+	err := b.mainDB.StartStreamSession(ctx, channelID, startedAt)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not start stream session in db")
+		return
+	}
+
+	// Paused channels and disabled commands do not use scraper quota, the session is still started so stats work once
+	// the commands are turned back on
+	channel, found, err := b.mainDB.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for user")
+		return
+	}
+	if !found || channel.CommandsPaused {
+		return
+	}
+
+	commands, err := b.mainDB.FindUserCommands(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for commands")
+		return
+	}
+
+	// Snapshotting the baseline is best-effort, accounts without a snapshot get one on their first use in the session
+	snapshotted := make(map[db.RLAccount]struct{})
+	for _, cmd := range *commands {
+		if !cmd.Enabled {
+			continue
+		}
+		for _, account := range cmd.RLAccounts {
+			if _, ok := snapshotted[account]; ok {
+				continue
+			}
+			snapshotted[account] = struct{}{}
+
+			rankRes, err := b.fetchRanks(ctx, account)
+			if err != nil {
+				var twirpErr twirp.Error
+				if errors.As(err, &twirpErr) && twirpErr.Code() == twirp.ResourceExhausted {
+					log.Ctx(ctx).Info().Err(err).Msg("Rank service is rate limited, skipping remaining stream session snapshots")
+					return
+				}
+				log.Ctx(ctx).Warn().Err(err).Str("platform", string(account.Platform)).Str("username", account.Username).Msg("Could not snapshot ranks for stream session")
+				continue
+			}
+			b.getSessionStats(ctx, channelID, []db.RLAccount{account}, []*trackerggscraper.PlayerCurrentRanksRes{rankRes})
+		}
+	}
+}
+
+func (b *bot) EndStreamSession(ctx context.Context, channelID string) {
+	log.Ctx(ctx).Info().Str("channel-id", channelID).Msg("Ending stream session")
+
+	err := b.mainDB.EndStreamSession(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not end stream session in db")
+	}
+}
+
+// getSessionStats updates the stream session of the channel with the given ranks and returns the session stats per
+// account. Nil is returned if the channel is not live.
+func (b *bot) getSessionStats(ctx context.Context, channelID string, accounts []db.RLAccount, rankResults []*trackerggscraper.PlayerCurrentRanksRes) []map[trackerggscraper.RankPlaylist]formatter.SessionStats {
+	_, isLive, err := b.mainDB.FindStreamSession(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for stream session")
+		return nil
+	}
+	if !isLive {
+		return nil
+	}
+
+	var observations []db.RankObservation
+	for i, account := range accounts {
+		for _, ranking := range rankResults[i].Ranks {
+			observations = append(observations, db.RankObservation{Account: account, Playlist: int32(ranking.Playlist), MMR: int(ranking.Mmr)})
+		}
+	}
+
+	// Wins and losses are counted by the database, as concurrent commands may observe the same MMR change
+	sessionRanks, err := b.mainDB.RecordStreamSessionRanks(ctx, channelID, observations, sessionMMRPerGame)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not update stream session ranks in db")
+		return nil
+	}
+
+	stats := make([]map[trackerggscraper.RankPlaylist]formatter.SessionStats, len(accounts))
+	n := 0
+	for i := range accounts {
+		stats[i] = make(map[trackerggscraper.RankPlaylist]formatter.SessionStats)
+		for _, ranking := range rankResults[i].Ranks {
+			sessionRank := sessionRanks[n]
+			n++
+			stats[i][ranking.Playlist] = formatter.SessionStats{
+				MMRDelta: sessionRank.LastMMR - sessionRank.StartMMR,
+				Wins:     sessionRank.Wins,
+				Losses:   sessionRank.Losses,
+			}
+		}
+	}
+
+	return stats
+}
+
+// peekSessionStats returns the session stats the given ranks would result in without updating the stream session.
+// Changes since the last recorded MMR are included in the MMR delta, but not yet counted as wins or losses.
+func (b *bot) peekSessionStats(ctx context.Context, channelID string, accounts []db.RLAccount, rankResults []*trackerggscraper.PlayerCurrentRanksRes) []map[trackerggscraper.RankPlaylist]formatter.SessionStats {
+	session, isLive, err := b.mainDB.FindStreamSession(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for stream session")
+		return nil
+	}
+	if !isLive {
+		return nil
+	}
+
+	type sessionRankKey struct {
+		account  db.RLAccount
+		playlist int32
+	}
+	sessionRanks := make(map[sessionRankKey]db.StreamSessionRank, len(session.Ranks))
+	for _, rank := range session.Ranks {
+		sessionRanks[sessionRankKey{account: rank.Account, playlist: rank.Playlist}] = rank
+	}
+
+	stats := make([]map[trackerggscraper.RankPlaylist]formatter.SessionStats, len(accounts))
+	for i, account := range accounts {
+		stats[i] = make(map[trackerggscraper.RankPlaylist]formatter.SessionStats)
+		for _, ranking := range rankResults[i].Ranks {
+			sessionRank, ok := sessionRanks[sessionRankKey{account: account, playlist: int32(ranking.Playlist)}]
+			if !ok {
+				stats[i][ranking.Playlist] = formatter.SessionStats{}
+				continue
+			}
+			stats[i][ranking.Playlist] = formatter.SessionStats{
+				MMRDelta: int(ranking.Mmr) - sessionRank.StartMMR,
+				Wins:     sessionRank.Wins,
+				Losses:   sessionRank.Losses,
+			}
+		}
+	}
+
+	return stats
+}
@@ -0,0 +1,81 @@
+package bot
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// invisibleSuffix is appended to messages by some third-party chat clients so Twitch does not reject repeated messages
+const invisibleSuffix = "\U000E0000"
+
+// tokenizeCommand splits a chat command into its arguments. Arguments are separated by any amount of whitespace and
+// can be wrapped in double quotes to contain whitespace. Inside of quotes, a backslash escapes a double quote or
+// another backslash. The invisible characters some chat clients append to messages are removed.
+func tokenizeCommand(command string) []string {
+	tokens, _ := scanTokens(cleanCommand(command))
+	return tokens
+}
+
+// commandRest returns the text following the first n arguments of a command as it was typed, for arguments like
+// formats that may contain quotes themselves
+func commandRest(command string, n int) string {
+	command = cleanCommand(command)
+	if n <= 0 {
+		return strings.TrimSpace(command)
+	}
+
+	_, ends := scanTokens(command)
+	if n > len(ends) {
+		return ""
+	}
+	return strings.TrimSpace(command[ends[n-1]:])
+}
+
+func cleanCommand(command string) string {
+	return strings.ReplaceAll(command, invisibleSuffix, "")
+}
+
+// scanTokens returns the arguments of a command and the byte offsets in command at which each of them ends
+func scanTokens(command string) ([]string, []int) {
+	var tokens []string
+	var ends []int
+
+	var current strings.Builder
+	inToken := false
+	inQuotes := false
+
+	for i := 0; i < len(command); {
+		r, size := utf8.DecodeRuneInString(command[i:])
+
+		switch {
+		case inQuotes && r == '\\' && i+size < len(command) && (command[i+size] == '"' || command[i+size] == '\\'):
+			current.WriteByte(command[i+size])
+			size++
+		case r == '"':
+			// Quotes can start or end anywhere in an argument, so "" is an empty argument
+			inQuotes = !inQuotes
+			inToken = true
+		case !inQuotes && unicode.IsSpace(r):
+			if inToken {
+				tokens = append(tokens, current.String())
+				ends = append(ends, i)
+				current.Reset()
+				inToken = false
+			}
+		default:
+			current.WriteRune(r)
+			inToken = true
+		}
+
+		i += size
+	}
+
+	// An unterminated quote extends to the end of the command
+	if inToken {
+		tokens = append(tokens, current.String())
+		ends = append(ends, len(command))
+	}
+
+	return tokens, ends
+}
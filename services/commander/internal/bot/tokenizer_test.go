@@ -0,0 +1,74 @@
+package bot
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestTokenizeCommand(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		want    []string
+	}{
+		{name: "empty", command: "", want: nil},
+		{name: "only whitespace", command: "   ", want: nil},
+		{name: "single word", command: "rank", want: []string{"rank"}},
+		{name: "simple arguments", command: "addcom rank epic player", want: []string{"addcom", "rank", "epic", "player"}},
+		{name: "double spaces", command: "addcom  rank   epic player", want: []string{"addcom", "rank", "epic", "player"}},
+		{name: "leading and trailing spaces", command: "  rank epic player ", want: []string{"rank", "epic", "player"}},
+		{name: "tabs and other whitespace", command: "rank\tepic player", want: []string{"rank", "epic", "player"}},
+		{name: "invisible suffix", command: "rank epic player \U000E0000", want: []string{"rank", "epic", "player"}},
+		{name: "invisible suffix inside argument", command: "rank epic pla\U000E0000yer", want: []string{"rank", "epic", "player"}},
+		{name: "only invisible suffix", command: "\U000E0000", want: nil},
+		{name: "quoted argument", command: `addcom rank epic "some player"`, want: []string{"addcom", "rank", "epic", "some player"}},
+		{name: "quoted argument with double spaces", command: `rank epic "some  player"`, want: []string{"rank", "epic", "some  player"}},
+		{name: "quoted command name", command: `"rank" epic player`, want: []string{"rank", "epic", "player"}},
+		{name: "quotes inside argument", command: `rank epic some" "player`, want: []string{"rank", "epic", "some player"}},
+		{name: "empty quotes", command: `editcom rank format ""`, want: []string{"editcom", "rank", "format", ""}},
+		{name: "adjacent quoted arguments", command: `"a""b" c`, want: []string{"ab", "c"}},
+		{name: "unterminated quote", command: `rank epic "some player`, want: []string{"rank", "epic", "some player"}},
+		{name: "escaped quote", command: `rank epic "say \"hi\""`, want: []string{"rank", "epic", `say "hi"`}},
+		{name: "escaped backslash", command: `rank epic "a\\b"`, want: []string{"rank", "epic", `a\b`}},
+		{name: "backslash outside of quotes", command: `rank epic a\"b`, want: []string{"rank", "epic", `a\b`}},
+		{name: "other escapes are kept", command: `rank "a\nb"`, want: []string{"rank", `a\nb`}},
+		{name: "trailing backslash in quotes", command: `rank "a\`, want: []string{"rank", `a\`}},
+		{name: "unicode arguments", command: "rank epic Spieler größer", want: []string{"rank", "epic", "Spieler", "größer"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tokenizeCommand(tt.command)
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("tokenizeCommand(%q) = %q, want %q", tt.command, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCommandRest(t *testing.T) {
+	tests := []struct {
+		name    string
+		command string
+		n       int
+		want    string
+	}{
+		{name: "whole command", command: " editcom rank ", n: 0, want: "editcom rank"},
+		{name: "format with quotes", command: `editcom rank format Rank: "$(2.r)"`, n: 3, want: `Rank: "$(2.r)"`},
+		{name: "format with double spaces", command: "editcom rank format $(2.r)  $(2.m)", n: 3, want: "$(2.r)  $(2.m)"},
+		{name: "quoted arguments before the rest", command: `editcom "rank" format $(2.r)`, n: 3, want: "$(2.r)"},
+		{name: "extra whitespace between arguments", command: "lookup   format    $(1.r)", n: 2, want: "$(1.r)"},
+		{name: "invisible suffix", command: "lookup format $(1.r) \U000E0000", n: 2, want: "$(1.r)"},
+		{name: "no rest", command: "lookup format", n: 2, want: ""},
+		{name: "fewer arguments than skipped", command: "lookup", n: 2, want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := commandRest(tt.command, tt.n)
+			if got != tt.want {
+				t.Errorf("commandRest(%q, %d) = %q, want %q", tt.command, tt.n, got, tt.want)
+			}
+		})
+	}
+}
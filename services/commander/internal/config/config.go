@@ -22,8 +22,9 @@ type CommanderConfig struct {
 	}
 
 	TTL struct {
-		Commands int
-		Ranks    int
+		Commands        int
+		Ranks           int
+		ChannelSettings int
 	}
 
 	Twitch struct {
@@ -34,6 +35,18 @@ type CommanderConfig struct {
 		WebHookSecret string
 	}
 
+	RankLookup struct {
+		UserCooldownSeconds  int
+		ChannelLimit         int
+		ChannelWindowSeconds int
+	}
+
+	RankAnnouncements struct {
+		PollIntervalSeconds int
+	}
+
+	CurrentSeason int
+
 	AdminUserIDs []string
 
 	CommandPrefix         string
@@ -0,0 +1,50 @@
+package db
+
+import (
+	"context"
+	"github.com/redis/go-redis/v9"
+	"time"
+)
+
+// acquireCommandCooldownScript checks the channel-wide and the per-user cooldown of a command and starts both in a
+// single step, so concurrent executions of the same command can never both pass. It returns the remaining cooldown in
+// milliseconds, or 0 if the cooldowns were acquired.
+var acquireCommandCooldownScript = redis.NewScript(`
+local remaining = redis.call('PTTL', KEYS[1])
+if remaining > 0 then
+	return remaining
+end
+if tonumber(ARGV[2]) > 0 then
+	remaining = redis.call('PTTL', KEYS[2])
+	if remaining > 0 then
+		return remaining
+	end
+end
+if tonumber(ARGV[1]) > 0 then
+	redis.call('SET', KEYS[1], 1, 'PX', ARGV[1])
+end
+if tonumber(ARGV[2]) > 0 then
+	redis.call('SET', KEYS[2], 1, 'PX', ARGV[2])
+end
+return 0
+`)
+
+// AcquireCommandCooldown atomically starts the channel-wide cooldown of a command and the cooldown of the user executing
+// it. A zero user cooldown disables the per-user dimension. If a cooldown is still running, the remaining time is
+// returned instead.
+func (c *cacheDB) AcquireCommandCooldown(ctx context.Context, channelID string, commandName string, userID string, cooldown time.Duration, userCooldown time.Duration) (bool, time.Duration, error) {
+	keys := []string{
+		cachePrefixCommandCooldowns + ":" + channelID + ":" + commandName,
+		cachePrefixUserCooldowns + ":" + channelID + ":" + commandName + ":" + userID,
+	}
+
+	remaining, err := acquireCommandCooldownScript.Run(ctx, c.client, keys, cooldown.Milliseconds(), userCooldown.Milliseconds()).Int64()
+	if err != nil {
+		return false, 0, err
+	}
+	if remaining > 0 {
+		return false, time.Millisecond * time.Duration(remaining), nil
+	}
+
+	return true, 0, nil
+}
@@ -0,0 +1,14 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// AcquireCooldownFeedback reports whether a user may be told about a cooldown of a command, which happens at most once
+// per window.
+func (c *cacheDB) AcquireCooldownFeedback(ctx context.Context, channelID string, commandName string, userID string, window time.Duration) (bool, error) {
+	cacheKey := cachePrefixCooldownFeedback + ":" + channelID + ":" + commandName + ":" + userID
+
+	return c.client.SetNX(ctx, cacheKey, 1, window).Result()
+}
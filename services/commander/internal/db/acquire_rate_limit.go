@@ -0,0 +1,21 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// AcquireRateLimit counts a hit against the fixed window identified by key and reports whether it is still within limit.
+func (c *cacheDB) AcquireRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error) {
+	cacheKey := cachePrefixRateLimit + ":" + key
+
+	pipe := c.client.TxPipeline()
+	incr := pipe.Incr(ctx, cacheKey)
+	pipe.ExpireNX(ctx, cacheKey, window)
+	_, err := pipe.Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return incr.Val() <= int64(limit), nil
+}
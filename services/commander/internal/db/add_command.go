@@ -2,21 +2,58 @@ package db
 
 import (
 	"context"
+	"github.com/jackc/pgx/v5"
 )
 
-func (m *mainDB) AddCommand(ctx context.Context, cmd *BotCommand) error {
-	res, err := m.dbPool.Query(ctx, "insert into "+
+func (m *mainDB) AddCommand(ctx context.Context, cmd *BotCommand, changedBy CommandAuthor) error {
+	tx, err := m.dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	err = insertCommand(ctx, tx, cmd)
+	if err != nil {
+		return err
+	}
+
+	err = addCommandHistory(ctx, tx, cmd.TwitchUserID, cmd.CommandName, CommandChangeAdd, nil, cmd, changedBy)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func insertCommand(ctx context.Context, tx pgx.Tx, cmd *BotCommand) error {
+	_, err := tx.Exec(ctx, "insert into "+
 		"bot_commands "+
 		"(command_name, command_cooldown_seconds, message_format, "+
-		"twitch_user_id, twitch_response_type, rl_platform, rl_username) "+
+		"twitch_user_id, twitch_response_type, command_type, permission_level, "+
+		"user_cooldown_seconds, cooldown_feedback, format_version, enabled) "+
 		"values "+
-		"($1, $2, $3, $4, $5, $6, $7);",
+		"($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11);",
 		cmd.CommandName, cmd.CommandCooldownSeconds, cmd.MessageFormat,
-		cmd.TwitchUserID, cmd.TwitchResponseType, cmd.RLPlatform, cmd.RLUsername)
-
-	if err == nil {
-		res.Close()
+		cmd.TwitchUserID, cmd.TwitchResponseType, cmd.CommandType, cmd.PermissionLevel,
+		cmd.UserCooldownSeconds, cmd.CooldownFeedback, cmd.FormatVersion, cmd.Enabled)
+	if err != nil {
+		return err
 	}
 
-	return err
+	return insertCommandAccounts(ctx, tx, cmd)
+}
+
+func insertCommandAccounts(ctx context.Context, tx pgx.Tx, cmd *BotCommand) error {
+	for i, account := range cmd.RLAccounts {
+		_, err := tx.Exec(ctx, "insert into "+
+			"bot_command_accounts "+
+			"(twitch_user_id, command_name, account_index, rl_platform, rl_username) "+
+			"values "+
+			"($1, $2, $3, $4, $5);",
+			cmd.TwitchUserID, cmd.CommandName, i+1, account.Platform, account.Username)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
@@ -0,0 +1,18 @@
+package db
+
+import "context"
+
+func (m *mainDB) AddCommandAlias(ctx context.Context, channelID string, aliasName string, commandName string) error {
+	res, err := m.dbPool.Query(ctx, "insert into "+
+		"bot_command_aliases "+
+		"(twitch_user_id, alias_name, command_name) "+
+		"values "+
+		"($1, $2, $3);",
+		channelID, aliasName, commandName)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
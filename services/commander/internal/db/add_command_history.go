@@ -0,0 +1,60 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5"
+)
+
+// addCommandHistory records a change of a command within the transaction that applies it
+func addCommandHistory(ctx context.Context, tx pgx.Tx, channelID string, commandName string, changeType CommandChangeType,
+	previous *BotCommand, cmd *BotCommand, changedBy CommandAuthor) error {
+	err := lockCommandHistory(ctx, tx, channelID, commandName)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "insert into "+
+		"bot_command_history "+
+		"(twitch_user_id, command_name, version, change_type, changed_by_id, changed_by_login, changed_at, "+
+		"previous_command, command) "+
+		"select $1, $2, coalesce(max(version), 0) + 1, $3, $4, $5, now(), $6, $7 "+
+		"from bot_command_history "+
+		"where "+
+		"twitch_user_id = $1 "+
+		"and command_name = $2;",
+		channelID, commandName, changeType, changedBy.TwitchUserID, changedBy.TwitchUserLogin, previous, cmd)
+	return err
+}
+
+// lockCommandHistory serializes changes of a command until the transaction ends. Concurrent changes would otherwise
+// compute the same next version. A row lock cannot be used because the first change of a command has no history rows yet.
+func lockCommandHistory(ctx context.Context, tx pgx.Tx, channelID string, commandName string) error {
+	_, err := tx.Exec(ctx, "select pg_advisory_xact_lock(hashtextextended($1 || '/' || $2, 0));",
+		channelID, commandName)
+	return err
+}
+
+// findCommandSnapshot finds a command by its exact name within a transaction, returning nil if it does not exist
+func findCommandSnapshot(ctx context.Context, tx pgx.Tx, channelID string, commandName string) (*BotCommand, error) {
+	bc := BotCommand{}
+	var platforms, usernames []string
+
+	err := tx.QueryRow(ctx, selectCommandsWithAccounts+
+		"where "+
+		"c.twitch_user_id = $1 and c.command_name = $2 "+
+		"group by c.twitch_user_id, c.command_name;",
+		channelID, commandName).Scan(&bc.CommandName, &bc.CommandCooldownSeconds, &bc.MessageFormat, &bc.TwitchUserID,
+		&bc.TwitchResponseType, &bc.CommandType, &bc.PermissionLevel, &bc.UserCooldownSeconds, &bc.CooldownFeedback, &bc.FormatVersion, &bc.Enabled, &platforms, &usernames, &bc.Aliases)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	bc.RLAccounts = zipRLAccounts(platforms, usernames)
+
+	return &bc, nil
+}
@@ -0,0 +1,31 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+)
+
+// AddRankObservations stores the observations in the rank history, skipping those that are unchanged from the latest
+// observation of the same account and playlist
+func (m *mainDB) AddRankObservations(ctx context.Context, observations []RankObservation) error {
+	batch := pgx.Batch{}
+	for _, observation := range observations {
+		batch.Queue("insert into "+
+			"rank_history "+
+			"(rl_platform, rl_username, playlist, mmr, rank, division, observed_at) "+
+			"select $1, $2, $3, $4, $5, $6, $7 "+
+			"where not exists ("+
+			"select 1 from ("+
+			"select mmr, rank, division from rank_history "+
+			"where rl_platform = $1 and rl_username = $2 and playlist = $3 "+
+			"order by observed_at desc limit 1"+
+			") latest "+
+			"where latest.mmr = $4 and latest.rank = $5 and latest.division = $6"+
+			") "+
+			"on conflict do nothing;",
+			observation.Account.Platform, observation.Account.Username, observation.Playlist, observation.MMR,
+			observation.Rank, observation.Division, observation.ObservedAt)
+	}
+
+	return m.dbPool.SendBatch(ctx, &batch).Close()
+}
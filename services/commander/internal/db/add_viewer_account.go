@@ -0,0 +1,19 @@
+package db
+
+import "context"
+
+func (m *mainDB) AddViewerAccount(ctx context.Context, twitchUserID string, account RLAccount) error {
+	res, err := m.dbPool.Query(ctx, "insert into "+
+		"viewer_accounts "+
+		"(twitch_user_id, rl_platform, rl_username, linked_at) "+
+		"values "+
+		"($1, $2, $3, now()) "+
+		"on conflict do nothing;",
+		twitchUserID, account.Platform, account.Username)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
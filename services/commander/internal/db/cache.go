@@ -10,10 +10,17 @@ import (
 )
 
 const (
-	cachePrefixCommands    = "command"
-	cachePrefixRanks       = "rank"
-	cachePrefixEventSubMsg = "eventsubmsg"
-	cacheKeyAppState       = "appstate"
+	cachePrefixCommands         = "command"
+	cachePrefixRanks            = "rank"
+	cachePrefixEventSubMsg      = "eventsubmsg"
+	cachePrefixRateLimit        = "ratelimit"
+	cachePrefixKnownRanks       = "knownrank"
+	cachePrefixCommandCooldowns = "commandcooldown"
+	cachePrefixUserCooldowns    = "commandusercooldown"
+	cachePrefixCooldownFeedback = "commandcooldownfeedback"
+	cachePrefixChannelSettings  = "channelsettings"
+	cacheKeyAppState            = "appstate"
+	cacheKeyBotUserToken        = "botusertoken"
 )
 
 type cacheDB struct {
@@ -30,8 +37,18 @@ type CacheDB interface {
 	InvalidateCachedCommand(ctx context.Context, channelID string, commandName string) error
 	SetCachedAppState(ctx context.Context, cachedAppState CachedAppState) error
 	GetCachedAppState(ctx context.Context) (*CachedAppState, bool, error)
-	AddCachedEventSubMsg(ctx context.Context, messageID string) error
-	HasCachedEventSubMsg(ctx context.Context, messageID string) (bool, error)
+	SetCachedBotUserToken(ctx context.Context, cachedBotUserToken CachedBotUserToken) error
+	GetCachedBotUserToken(ctx context.Context) (*CachedBotUserToken, bool, error)
+	ClaimCachedEventSubMsg(ctx context.Context, messageID string) (bool, error)
+	ReleaseCachedEventSubMsg(ctx context.Context, messageID string) error
+	AcquireRateLimit(ctx context.Context, key string, limit int, window time.Duration) (bool, error)
+	FindCachedKnownRanks(ctx context.Context, channelID string, account RLAccount) (map[int32]KnownRank, bool, error)
+	SetCachedKnownRanks(ctx context.Context, channelID string, account RLAccount, ranks map[int32]KnownRank, ttl time.Duration) error
+	AcquireCommandCooldown(ctx context.Context, channelID string, commandName string, userID string, cooldown time.Duration, userCooldown time.Duration) (bool, time.Duration, error)
+	AcquireCooldownFeedback(ctx context.Context, channelID string, commandName string, userID string, window time.Duration) (bool, error)
+	FindCachedChannelSettings(ctx context.Context, channelID string) (*CachedChannelSettings, bool, error)
+	SetCachedChannelSettings(ctx context.Context, channelID string, settings *CachedChannelSettings, ttl time.Duration) error
+	InvalidateCachedChannelSettings(ctx context.Context, channelID string) error
 }
 
 func NewCache(cfg *config.CommanderConfig) (CacheDB, error) {
@@ -0,0 +1,14 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// ClaimCachedEventSubMsg marks an EventSub message as handled and reports whether it was not marked before. The check
+// and the mark are a single SET NX, so only one of several deliveries of a message, possibly to different instances,
+// claims it.
+func (c *cacheDB) ClaimCachedEventSubMsg(ctx context.Context, messageID string) (bool, error) {
+	cacheKey := cachePrefixEventSubMsg + ":" + messageID
+	return c.client.SetNX(ctx, cacheKey, "1", time.Minute*11).Result()
+}
@@ -4,17 +4,32 @@ import (
 	"context"
 )
 
-func (m *mainDB) DeleteCommand(ctx context.Context, channelId string, commandName string) error {
-	res, err := m.dbPool.Query(ctx, "delete from "+
+func (m *mainDB) DeleteCommand(ctx context.Context, channelId string, commandName string, changedBy CommandAuthor) error {
+	tx, err := m.dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	previous, err := findCommandSnapshot(ctx, tx, channelId, commandName)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "delete from "+
 		"bot_commands "+
 		"where "+
 		"twitch_user_id = $1 "+
 		"and command_name = $2;",
 		channelId, commandName)
+	if err != nil {
+		return err
+	}
 
-	if err == nil {
-		res.Close()
+	err = addCommandHistory(ctx, tx, channelId, commandName, CommandChangeDelete, previous, nil, changedBy)
+	if err != nil {
+		return err
 	}
 
-	return err
+	return tx.Commit(ctx)
 }
@@ -0,0 +1,18 @@
+package db
+
+import "context"
+
+func (m *mainDB) DeleteCommandAlias(ctx context.Context, channelID string, aliasName string) error {
+	res, err := m.dbPool.Query(ctx, "delete from "+
+		"bot_command_aliases "+
+		"where "+
+		"twitch_user_id = $1 "+
+		"and alias_name = $2;",
+		channelID, aliasName)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
@@ -0,0 +1,18 @@
+package db
+
+import "context"
+
+func (m *mainDB) DeleteKeywordTrigger(ctx context.Context, channelID string, keyword string) error {
+	res, err := m.dbPool.Query(ctx, "delete from "+
+		"keyword_triggers "+
+		"where "+
+		"twitch_user_id = $1 "+
+		"and keyword = $2;",
+		channelID, keyword)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
@@ -0,0 +1,18 @@
+package db
+
+import "context"
+
+func (m *mainDB) DeleteLeaderboardEntry(ctx context.Context, channelID string, twitchUserID string) error {
+	res, err := m.dbPool.Query(ctx, "delete from "+
+		"leaderboard_entries "+
+		"where "+
+		"channel_id = $1 "+
+		"and twitch_user_id = $2;",
+		channelID, twitchUserID)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
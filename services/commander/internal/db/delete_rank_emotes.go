@@ -0,0 +1,19 @@
+package db
+
+import "context"
+
+// DeleteRankEmotes removes the emote of a single rank tier, or of all tiers if rank is negative
+func (m *mainDB) DeleteRankEmotes(ctx context.Context, channelID string, rank int) error {
+	res, err := m.dbPool.Query(ctx, "delete from "+
+		"rank_emotes "+
+		"where "+
+		"twitch_user_id = $1 "+
+		"and ($2 < 0 or rank = $2);",
+		channelID, rank)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
@@ -2,6 +2,8 @@ package db
 
 import "context"
 
+// DeleteUserData removes everything the user has configured as a broadcaster. Accounts the user linked as a viewer
+// are kept, as they are not tied to the user's channel.
 func (m *mainDB) DeleteUserData(ctx context.Context, twitchUserID string) error {
 	rows, err := m.dbPool.Query(ctx, "delete from "+
 		"event_sub_subscriptions "+
@@ -21,6 +23,50 @@ func (m *mainDB) DeleteUserData(ctx context.Context, twitchUserID string) error
 	}
 	rows.Close()
 
+	rows, err = m.dbPool.Query(ctx, "delete from "+
+		"bot_command_history "+
+		"where "+
+		"twitch_user_id = $1;", twitchUserID)
+	if err != nil {
+		return err
+	}
+	rows.Close()
+	rows, err = m.dbPool.Query(ctx, "delete from "+
+		"api_keys "+
+		"where "+
+		"twitch_user_id = $1;", twitchUserID)
+	if err != nil {
+		return err
+	}
+	rows.Close()
+
+	rows, err = m.dbPool.Query(ctx, "delete from "+
+		"leaderboard_entries "+
+		"where "+
+		"channel_id = $1;", twitchUserID)
+	if err != nil {
+		return err
+	}
+	rows.Close()
+
+	rows, err = m.dbPool.Query(ctx, "delete from "+
+		"rank_emotes "+
+		"where "+
+		"twitch_user_id = $1;", twitchUserID)
+	if err != nil {
+		return err
+	}
+	rows.Close()
+
+	rows, err = m.dbPool.Query(ctx, "delete from "+
+		"stream_sessions "+
+		"where "+
+		"twitch_user_id = $1;", twitchUserID)
+	if err != nil {
+		return err
+	}
+	rows.Close()
+
 	rows, err = m.dbPool.Query(ctx, "delete from "+
 		"bot_users "+
 		"where "+
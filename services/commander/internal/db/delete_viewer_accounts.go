@@ -0,0 +1,17 @@
+package db
+
+import "context"
+
+func (m *mainDB) DeleteViewerAccounts(ctx context.Context, twitchUserID string) error {
+	res, err := m.dbPool.Query(ctx, "delete from "+
+		"viewer_accounts "+
+		"where "+
+		"twitch_user_id = $1;",
+		twitchUserID)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5"
+)
+
+func (m *mainDB) FindAPIKey(ctx context.Context, keyHash string) (*APIKey, bool, error) {
+	key := APIKey{KeyHash: keyHash}
+
+	err := m.dbPool.QueryRow(ctx, "select "+
+		"twitch_user_id, created_by_id, created_by_login, created_at "+
+		"from api_keys "+
+		"where "+
+		"key_hash = $1;",
+		keyHash).Scan(&key.TwitchUserID, &key.CreatedBy.TwitchUserID, &key.CreatedBy.TwitchUserLogin, &key.CreatedAt)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	return &key, true, nil
+}
@@ -0,0 +1,28 @@
+package db
+
+import "context"
+
+// FindAuthenticatedUserIDs returns the IDs of all users whose authorization has not been revoked
+func (m *mainDB) FindAuthenticatedUserIDs(ctx context.Context) ([]string, error) {
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"twitch_user_id "+
+		"from bot_users "+
+		"where "+
+		"is_authenticated;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		err = rows.Scan(&id)
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, rows.Err()
+}
@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5"
+)
+
+func (m *mainDB) FindBotUserRefreshToken(ctx context.Context, twitchUserID string) (string, bool, error) {
+	var refreshToken string
+
+	err := m.dbPool.QueryRow(ctx, "select "+
+		"refresh_token "+
+		"from bot_user_tokens "+
+		"where "+
+		"twitch_user_id = $1;",
+		twitchUserID).Scan(&refreshToken)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	return refreshToken, true, nil
+}
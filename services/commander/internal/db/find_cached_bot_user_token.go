@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/redis/go-redis/v9"
+)
+
+func (c *cacheDB) GetCachedBotUserToken(ctx context.Context) (*CachedBotUserToken, bool, error) {
+	cachedString, err := c.client.Get(ctx, cacheKeyBotUserToken).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	cbt := CachedBotUserToken{}
+
+	err = json.Unmarshal([]byte(cachedString), &cbt)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &cbt, true, nil
+}
@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/redis/go-redis/v9"
+)
+
+func (c *cacheDB) FindCachedChannelSettings(ctx context.Context, channelID string) (*CachedChannelSettings, bool, error) {
+	cachedString, err := c.client.Get(ctx, cachePrefixChannelSettings+":"+channelID).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	cs := CachedChannelSettings{}
+
+	err = json.Unmarshal([]byte(cachedString), &cs)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &cs, true, nil
+}
@@ -0,0 +1,27 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/redis/go-redis/v9"
+)
+
+func (c *cacheDB) FindCachedKnownRanks(ctx context.Context, channelID string, account RLAccount) (map[int32]KnownRank, bool, error) {
+	cachedString, err := c.client.Get(ctx, cachePrefixKnownRanks+":"+channelID+":"+string(account.Platform)+":"+account.Username).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	knownRanks := make(map[int32]KnownRank)
+
+	err = json.Unmarshal([]byte(cachedString), &knownRanks)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return knownRanks, true, nil
+}
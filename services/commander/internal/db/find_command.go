@@ -6,17 +6,29 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
+const selectCommandsWithAccounts = "select " +
+	"c.command_name, c.command_cooldown_seconds, c.message_format, " +
+	"c.twitch_user_id, c.twitch_response_type, c.command_type, c.permission_level, " +
+	"c.user_cooldown_seconds, c.cooldown_feedback, c.format_version, c.enabled, " +
+	"coalesce(array_agg(a.rl_platform order by a.account_index) filter (where a.account_index is not null), '{}'), " +
+	"coalesce(array_agg(a.rl_username order by a.account_index) filter (where a.account_index is not null), '{}'), " +
+	"coalesce((select array_agg(al.alias_name order by al.alias_name) from bot_command_aliases al " +
+	"where al.twitch_user_id = c.twitch_user_id and al.command_name = c.command_name), '{}') " +
+	"from bot_commands c " +
+	"left join bot_command_accounts a using (twitch_user_id, command_name) "
+
+// FindCommand finds a command by its name or one of its aliases
 func (m *mainDB) FindCommand(ctx context.Context, channelID string, commandName string) (*BotCommand, bool, error) {
 	bc := BotCommand{}
+	var platforms, usernames []string
 
-	err := m.dbPool.QueryRow(ctx, "select "+
-		"command_name, command_cooldown_seconds, message_format, "+
-		"twitch_user_id, twitch_response_type, rl_platform, rl_username "+
-		"from bot_commands "+
+	err := m.dbPool.QueryRow(ctx, selectCommandsWithAccounts+
 		"where "+
-		"twitch_user_id = $1 and command_name = $2;",
+		"c.twitch_user_id = $1 and c.command_name = coalesce("+
+		"(select command_name from bot_command_aliases where twitch_user_id = $1 and alias_name = $2), $2) "+
+		"group by c.twitch_user_id, c.command_name;",
 		channelID, commandName).Scan(&bc.CommandName, &bc.CommandCooldownSeconds, &bc.MessageFormat, &bc.TwitchUserID,
-		&bc.TwitchResponseType, &bc.RLPlatform, &bc.RLUsername)
+		&bc.TwitchResponseType, &bc.CommandType, &bc.PermissionLevel, &bc.UserCooldownSeconds, &bc.CooldownFeedback, &bc.FormatVersion, &bc.Enabled, &platforms, &usernames, &bc.Aliases)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
@@ -25,5 +37,15 @@ func (m *mainDB) FindCommand(ctx context.Context, channelID string, commandName
 		return nil, false, err
 	}
 
+	bc.RLAccounts = zipRLAccounts(platforms, usernames)
+
 	return &bc, true, nil
 }
+
+func zipRLAccounts(platforms []string, usernames []string) []RLAccount {
+	accounts := make([]RLAccount, 0, len(platforms))
+	for i := range platforms {
+		accounts = append(accounts, RLAccount{Platform: RLPlatform(platforms[i]), Username: usernames[i]})
+	}
+	return accounts
+}
@@ -0,0 +1,36 @@
+package db
+
+import "context"
+
+const selectCommandHistory = "select " +
+	"twitch_user_id, command_name, version, change_type, changed_by_id, changed_by_login, changed_at, " +
+	"previous_command, command, undone_at, undone_by_login " +
+	"from bot_command_history "
+
+// FindCommandHistory finds the latest changes of a command, newest first
+func (m *mainDB) FindCommandHistory(ctx context.Context, channelID string, commandName string, limit int) ([]CommandHistoryEntry, error) {
+	rows, err := m.dbPool.Query(ctx, selectCommandHistory+
+		"where "+
+		"twitch_user_id = $1 "+
+		"and command_name = $2 "+
+		"order by version desc "+
+		"limit $3;",
+		channelID, commandName, limit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var entries []CommandHistoryEntry
+	for rows.Next() {
+		e := CommandHistoryEntry{}
+		err = rows.Scan(&e.TwitchUserID, &e.CommandName, &e.Version, &e.ChangeType, &e.ChangedBy.TwitchUserID,
+			&e.ChangedBy.TwitchUserLogin, &e.ChangedAt, &e.PreviousCommand, &e.Command, &e.UndoneAt, &e.UndoneByLogin)
+		if err != nil {
+			return nil, err
+		}
+		entries = append(entries, e)
+	}
+
+	return entries, rows.Err()
+}
@@ -0,0 +1,33 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// FindDivisionThresholds returns the lowest MMR observed in every division of every playlist since the given time
+func (m *mainDB) FindDivisionThresholds(ctx context.Context, since time.Time) ([]DivisionThreshold, error) {
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"playlist, rank, division, min(mmr) "+
+		"from rank_history "+
+		"where "+
+		"observed_at >= $1 "+
+		"group by playlist, rank, division;",
+		since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var thresholds []DivisionThreshold
+	for rows.Next() {
+		threshold := DivisionThreshold{}
+		err = rows.Scan(&threshold.Playlist, &threshold.Rank, &threshold.Division, &threshold.MMR)
+		if err != nil {
+			return nil, err
+		}
+		thresholds = append(thresholds, threshold)
+	}
+
+	return thresholds, rows.Err()
+}
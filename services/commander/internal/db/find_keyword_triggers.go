@@ -0,0 +1,30 @@
+package db
+
+import "context"
+
+// FindKeywordTriggers returns the commands of a channel by the keyword that triggers them
+func (m *mainDB) FindKeywordTriggers(ctx context.Context, channelID string) (map[string]string, error) {
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"keyword, command_name "+
+		"from keyword_triggers "+
+		"where "+
+		"twitch_user_id = $1;",
+		channelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	triggers := make(map[string]string)
+	for rows.Next() {
+		var keyword string
+		var commandName string
+		err = rows.Scan(&keyword, &commandName)
+		if err != nil {
+			return nil, err
+		}
+		triggers[keyword] = commandName
+	}
+
+	return triggers, rows.Err()
+}
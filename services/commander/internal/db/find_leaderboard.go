@@ -0,0 +1,40 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// FindLeaderboard ranks the entries of the channel by the MMR of their latest observation in the rank history. Entries
+// without an observation in the playlist since observedSince are left out, so outdated ranks are never shown as current.
+func (m *mainDB) FindLeaderboard(ctx context.Context, channelID string, playlist int32, observedSince time.Time, limit int) ([]LeaderboardRanking, error) {
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"e.twitch_user_login, h.mmr, h.rank, h.division "+
+		"from leaderboard_entries e "+
+		"join lateral ("+
+		"select mmr, rank, division from rank_history "+
+		"where rl_platform = e.rl_platform and rl_username = e.rl_username and playlist = $2 and observed_at >= $4 "+
+		"order by observed_at desc limit 1"+
+		") h on true "+
+		"where "+
+		"e.channel_id = $1 "+
+		"order by h.mmr desc "+
+		"limit $3;",
+		channelID, playlist, limit, observedSince)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var rankings []LeaderboardRanking
+	for rows.Next() {
+		ranking := LeaderboardRanking{}
+		err = rows.Scan(&ranking.TwitchUserLogin, &ranking.MMR, &ranking.Rank, &ranking.Division)
+		if err != nil {
+			return nil, err
+		}
+		rankings = append(rankings, ranking)
+	}
+
+	return rankings, rows.Err()
+}
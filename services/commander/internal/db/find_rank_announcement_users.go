@@ -0,0 +1,30 @@
+package db
+
+import "context"
+
+// FindRankAnnouncementUsers returns all users that are live and have rank announcements enabled
+func (m *mainDB) FindRankAnnouncementUsers(ctx context.Context) ([]BotUser, error) {
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"u.twitch_user_id, u.is_authenticated, u.lookup_enabled, u.lookup_format, u.announce_enabled, u.announce_format, u.language, u.command_prefix, u.commands_paused "+
+		"from bot_users u "+
+		"join stream_sessions s using (twitch_user_id) "+
+		"where "+
+		"u.announce_enabled and u.is_authenticated;")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var users []BotUser
+	for rows.Next() {
+		bu := BotUser{}
+		err = rows.Scan(&bu.TwitchUserID, &bu.IsAuthenticated, &bu.LookupEnabled, &bu.LookupFormat,
+			&bu.AnnounceEnabled, &bu.AnnounceFormat, &bu.Language, &bu.CommandPrefix, &bu.CommandsPaused)
+		if err != nil {
+			return nil, err
+		}
+		users = append(users, bu)
+	}
+
+	return users, rows.Err()
+}
@@ -0,0 +1,30 @@
+package db
+
+import "context"
+
+// FindRankEmotes returns the emotes of a channel by rank tier
+func (m *mainDB) FindRankEmotes(ctx context.Context, channelID string) (map[int]string, error) {
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"rank, emote "+
+		"from rank_emotes "+
+		"where "+
+		"twitch_user_id = $1;",
+		channelID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	emotes := make(map[int]string)
+	for rows.Next() {
+		var rank int
+		var emote string
+		err = rows.Scan(&rank, &emote)
+		if err != nil {
+			return nil, err
+		}
+		emotes[rank] = emote
+	}
+
+	return emotes, rows.Err()
+}
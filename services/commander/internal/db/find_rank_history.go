@@ -0,0 +1,56 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+	"time"
+)
+
+// FindLatestRankObservations returns the most recent observation of every playlist of the account
+func (m *mainDB) FindLatestRankObservations(ctx context.Context, account RLAccount) ([]RankObservation, error) {
+	rows, err := m.dbPool.Query(ctx, "select distinct on (playlist) "+
+		"playlist, mmr, rank, division, observed_at "+
+		"from rank_history "+
+		"where "+
+		"rl_platform = $1 and rl_username = $2 "+
+		"order by playlist, observed_at desc;",
+		account.Platform, account.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRankObservations(rows, account)
+}
+
+// FindRankHistory returns all observations of the account in the playlist since the given time, oldest first
+func (m *mainDB) FindRankHistory(ctx context.Context, account RLAccount, playlist int32, since time.Time) ([]RankObservation, error) {
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"playlist, mmr, rank, division, observed_at "+
+		"from rank_history "+
+		"where "+
+		"rl_platform = $1 and rl_username = $2 and playlist = $3 and observed_at >= $4 "+
+		"order by observed_at;",
+		account.Platform, account.Username, playlist, since)
+	if err != nil {
+		return nil, err
+	}
+
+	return scanRankObservations(rows, account)
+}
+
+func scanRankObservations(rows pgx.Rows, account RLAccount) ([]RankObservation, error) {
+	defer rows.Close()
+
+	var observations []RankObservation
+	for rows.Next() {
+		observation := RankObservation{Account: account}
+		err := rows.Scan(&observation.Playlist, &observation.MMR, &observation.Rank, &observation.Division,
+			&observation.ObservedAt)
+		if err != nil {
+			return nil, err
+		}
+		observations = append(observations, observation)
+	}
+
+	return observations, rows.Err()
+}
@@ -0,0 +1,29 @@
+package db
+
+import "context"
+
+// FindRankPeaks returns the peaks of every playlist and season of the account
+func (m *mainDB) FindRankPeaks(ctx context.Context, account RLAccount) ([]RankPeak, error) {
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"playlist, season, peak_mmr, peak_rank, peak_division "+
+		"from rank_peaks "+
+		"where "+
+		"rl_platform = $1 and rl_username = $2;",
+		account.Platform, account.Username)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var peaks []RankPeak
+	for rows.Next() {
+		peak := RankPeak{Account: account}
+		err = rows.Scan(&peak.Playlist, &peak.Season, &peak.PeakMMR, &peak.PeakRank, &peak.PeakDivision)
+		if err != nil {
+			return nil, err
+		}
+		peaks = append(peaks, peak)
+	}
+
+	return peaks, rows.Err()
+}
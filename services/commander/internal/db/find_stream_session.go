@@ -0,0 +1,48 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5"
+)
+
+func (m *mainDB) FindStreamSession(ctx context.Context, twitchUserID string) (*StreamSession, bool, error) {
+	session := StreamSession{TwitchUserID: twitchUserID}
+
+	err := m.dbPool.QueryRow(ctx, "select "+
+		"started_at "+
+		"from stream_sessions "+
+		"where "+
+		"twitch_user_id = $1;",
+		twitchUserID).Scan(&session.StartedAt)
+
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"rl_platform, rl_username, playlist, start_mmr, last_mmr, wins, losses "+
+		"from stream_session_ranks "+
+		"where "+
+		"twitch_user_id = $1;",
+		twitchUserID)
+	if err != nil {
+		return nil, false, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		rank := StreamSessionRank{}
+		err = rows.Scan(&rank.Account.Platform, &rank.Account.Username, &rank.Playlist, &rank.StartMMR, &rank.LastMMR,
+			&rank.Wins, &rank.Losses)
+		if err != nil {
+			return nil, false, err
+		}
+		session.Ranks = append(session.Ranks, rank)
+	}
+
+	return &session, true, nil
+}
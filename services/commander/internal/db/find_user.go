@@ -10,11 +10,12 @@ func (m *mainDB) FindUser(ctx context.Context, twitchUserID string) (*BotUser, b
 	bu := BotUser{}
 
 	err := m.dbPool.QueryRow(ctx, "select "+
-		"twitch_user_id, is_authenticated "+
+		"twitch_user_id, is_authenticated, lookup_enabled, lookup_format, announce_enabled, announce_format, language, command_prefix, commands_paused "+
 		"from bot_users "+
 		"where "+
 		"twitch_user_id = $1;",
-		twitchUserID).Scan(&bu.TwitchUserID, &bu.IsAuthenticated)
+		twitchUserID).Scan(&bu.TwitchUserID, &bu.IsAuthenticated, &bu.LookupEnabled, &bu.LookupFormat,
+		&bu.AnnounceEnabled, &bu.AnnounceFormat, &bu.Language, &bu.CommandPrefix, &bu.CommandsPaused)
 
 	if err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
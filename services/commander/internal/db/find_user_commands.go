@@ -7,12 +7,10 @@ import (
 )
 
 func (m *mainDB) FindUserCommands(ctx context.Context, channelID string) (*[]BotCommand, error) {
-	rows, err := m.dbPool.Query(ctx, "select "+
-		"command_name, command_cooldown_seconds, message_format, "+
-		"twitch_user_id, twitch_response_type, rl_platform, rl_username "+
-		"from bot_commands "+
+	rows, err := m.dbPool.Query(ctx, selectCommandsWithAccounts+
 		"where "+
-		"twitch_user_id = $1;",
+		"c.twitch_user_id = $1 "+
+		"group by c.twitch_user_id, c.command_name;",
 		channelID)
 
 	if err != nil {
@@ -27,11 +25,13 @@ func (m *mainDB) FindUserCommands(ctx context.Context, channelID string) (*[]Bot
 	var commands []BotCommand
 	for rows.Next() {
 		cmd := BotCommand{}
+		var platforms, usernames []string
 		err = rows.Scan(&cmd.CommandName, &cmd.CommandCooldownSeconds, &cmd.MessageFormat, &cmd.TwitchUserID,
-			&cmd.TwitchResponseType, &cmd.RLPlatform, &cmd.RLUsername)
+			&cmd.TwitchResponseType, &cmd.CommandType, &cmd.PermissionLevel, &cmd.UserCooldownSeconds, &cmd.CooldownFeedback, &cmd.FormatVersion, &cmd.Enabled, &platforms, &usernames, &cmd.Aliases)
 		if err != nil {
 			return nil, err
 		}
+		cmd.RLAccounts = zipRLAccounts(platforms, usernames)
 		commands = append(commands, cmd)
 	}
 
@@ -0,0 +1,29 @@
+package db
+
+import "context"
+
+func (m *mainDB) FindViewerAccounts(ctx context.Context, twitchUserID string) ([]RLAccount, error) {
+	rows, err := m.dbPool.Query(ctx, "select "+
+		"rl_platform, rl_username "+
+		"from viewer_accounts "+
+		"where "+
+		"twitch_user_id = $1 "+
+		"order by linked_at;",
+		twitchUserID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	var accounts []RLAccount
+	for rows.Next() {
+		account := RLAccount{}
+		err = rows.Scan(&account.Platform, &account.Username)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, account)
+	}
+
+	return accounts, rows.Err()
+}
@@ -0,0 +1,65 @@
+package db
+
+import "context"
+
+// ImportCommands adds or overwrites several commands of a channel at once. Either all commands are imported or none.
+func (m *mainDB) ImportCommands(ctx context.Context, channelID string, cmds []BotCommand, changedBy CommandAuthor) error {
+	tx, err := m.dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	for i := range cmds {
+		cmd := &cmds[i]
+		cmd.TwitchUserID = channelID
+
+		// An imported command takes precedence over an alias with the same name
+		_, err = tx.Exec(ctx, "delete from "+
+			"bot_command_aliases "+
+			"where "+
+			"twitch_user_id = $1 "+
+			"and alias_name = $2;",
+			channelID, cmd.CommandName)
+		if err != nil {
+			return err
+		}
+
+		previous, err := findCommandSnapshot(ctx, tx, channelID, cmd.CommandName)
+		if err != nil {
+			return err
+		}
+
+		changeType := CommandChangeEdit
+		if previous == nil {
+			changeType = CommandChangeAdd
+			err = insertCommand(ctx, tx, cmd)
+		} else {
+			_, err = updateCommand(ctx, tx, cmd)
+		}
+		if err != nil {
+			return err
+		}
+
+		for _, alias := range cmd.Aliases {
+			_, err = tx.Exec(ctx, "insert into "+
+				"bot_command_aliases "+
+				"(twitch_user_id, alias_name, command_name) "+
+				"values "+
+				"($1, $2, $3) "+
+				"on conflict (twitch_user_id, alias_name) do update "+
+				"set command_name = excluded.command_name;",
+				channelID, alias, cmd.CommandName)
+			if err != nil {
+				return err
+			}
+		}
+
+		err = addCommandHistory(ctx, tx, channelID, cmd.CommandName, changeType, previous, cmd, changedBy)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,9 @@
+package db
+
+import "context"
+
+func (c *cacheDB) InvalidateCachedChannelSettings(ctx context.Context, channelID string) error {
+	cacheKey := cachePrefixChannelSettings + ":" + channelID
+	res := c.client.Del(ctx, cacheKey)
+	return res.Err()
+}
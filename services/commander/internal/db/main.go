@@ -19,15 +19,53 @@ type MainDB interface {
 	FindUserCommands(ctx context.Context, channelID string) (*[]BotCommand, error)
 	FindUser(ctx context.Context, twitchUserID string) (*BotUser, bool, error)
 	AddUser(ctx context.Context, user *BotUser) error
-	AddCommand(ctx context.Context, cmd *BotCommand) error
-	UpdateCommand(ctx context.Context, cmd *BotCommand) error
-	DeleteCommand(ctx context.Context, channelId string, commandName string) error
+	AddCommand(ctx context.Context, cmd *BotCommand, changedBy CommandAuthor) error
+	UpdateCommand(ctx context.Context, cmd *BotCommand, changedBy CommandAuthor) error
+	DeleteCommand(ctx context.Context, channelId string, commandName string, changedBy CommandAuthor) error
+	FindCommandHistory(ctx context.Context, channelID string, commandName string, limit int) ([]CommandHistoryEntry, error)
+	UndoCommandChange(ctx context.Context, channelID string, commandName string, undoneBy CommandAuthor) (*CommandHistoryEntry, bool, error)
+	ImportCommands(ctx context.Context, channelID string, cmds []BotCommand, changedBy CommandAuthor) error
+	AddCommandAlias(ctx context.Context, channelID string, aliasName string, commandName string) error
+	DeleteCommandAlias(ctx context.Context, channelID string, aliasName string) error
 	DeleteUserData(ctx context.Context, twitchUserID string) error
 	FindEventSubSubscriptionsForTwitchUserID(ctx context.Context, twitchUserID string) (*[]EventSubSubscription, error)
 	AddEventSubSubscription(ctx context.Context, sub *EventSubSubscription) error
 	DeleteEventSubSubscription(ctx context.Context, subscriptionID string) error
 	FindEventSubSubscriptionByID(ctx context.Context, eventSubID string) (*EventSubSubscription, bool, error)
 	UpdateUserAuthenticationFlag(ctx context.Context, twitchUserID string, isAuthed bool) error
+	UpdateUserLookupSettings(ctx context.Context, twitchUserID string, enabled bool, format string) error
+	UpdateUserAnnounceSettings(ctx context.Context, twitchUserID string, enabled bool, format string) error
+	UpdateUserLanguage(ctx context.Context, twitchUserID string, language string) error
+	UpdateUserCommandPrefix(ctx context.Context, twitchUserID string, prefix string) error
+	UpdateUserCommandsPaused(ctx context.Context, twitchUserID string, paused bool) error
+	FindRankAnnouncementUsers(ctx context.Context) ([]BotUser, error)
+	FindAuthenticatedUserIDs(ctx context.Context) ([]string, error)
+	FindViewerAccounts(ctx context.Context, twitchUserID string) ([]RLAccount, error)
+	AddViewerAccount(ctx context.Context, twitchUserID string, account RLAccount) error
+	DeleteViewerAccounts(ctx context.Context, twitchUserID string) error
+	UpsertLeaderboardEntry(ctx context.Context, entry *LeaderboardEntry) error
+	DeleteLeaderboardEntry(ctx context.Context, channelID string, twitchUserID string) error
+	FindLeaderboard(ctx context.Context, channelID string, playlist int32, observedSince time.Time, limit int) ([]LeaderboardRanking, error)
+	StartStreamSession(ctx context.Context, twitchUserID string, startedAt time.Time) error
+	EndStreamSession(ctx context.Context, twitchUserID string) error
+	FindStreamSession(ctx context.Context, twitchUserID string) (*StreamSession, bool, error)
+	RecordStreamSessionRanks(ctx context.Context, twitchUserID string, observations []RankObservation, mmrPerGame int) ([]StreamSessionRank, error)
+	AddRankObservations(ctx context.Context, observations []RankObservation) error
+	FindLatestRankObservations(ctx context.Context, account RLAccount) ([]RankObservation, error)
+	FindRankHistory(ctx context.Context, account RLAccount, playlist int32, since time.Time) ([]RankObservation, error)
+	UpdateRankPeaks(ctx context.Context, peaks []RankPeak) error
+	FindRankPeaks(ctx context.Context, account RLAccount) ([]RankPeak, error)
+	FindDivisionThresholds(ctx context.Context, since time.Time) ([]DivisionThreshold, error)
+	FindRankEmotes(ctx context.Context, channelID string) (map[int]string, error)
+	UpsertRankEmote(ctx context.Context, channelID string, rank int, emote string) error
+	DeleteRankEmotes(ctx context.Context, channelID string, rank int) error
+	FindKeywordTriggers(ctx context.Context, channelID string) (map[string]string, error)
+	UpsertKeywordTrigger(ctx context.Context, channelID string, keyword string, commandName string) error
+	DeleteKeywordTrigger(ctx context.Context, channelID string, keyword string) error
+	UpsertAPIKey(ctx context.Context, channelID string, keyHash string, createdBy CommandAuthor) error
+	FindAPIKey(ctx context.Context, keyHash string) (*APIKey, bool, error)
+	UpsertBotUserToken(ctx context.Context, twitchUserID string, refreshToken string) error
+	FindBotUserRefreshToken(ctx context.Context, twitchUserID string) (string, bool, error)
 }
 
 func NewMainDB(cfg *config.CommanderConfig) (MainDB, error) {
@@ -26,9 +26,68 @@ const (
 	TwitchResponseTypeMention TwitchResponseType = "mention"
 )
 
+type CommandType string
+
+const (
+	CommandTypeRank    CommandType = "rank"
+	CommandTypeCompare CommandType = "compare"
+)
+
+type CooldownFeedback string
+
+const (
+	CooldownFeedbackSilent  CooldownFeedback = "silent"
+	CooldownFeedbackWhisper CooldownFeedback = "whisper"
+	CooldownFeedbackReply   CooldownFeedback = "reply"
+)
+
+type FormatVersion int
+
+const (
+	// FormatVersionTokens formats use $(...) tokens
+	FormatVersionTokens FormatVersion = 1
+	// FormatVersionTemplate formats use the text/template syntax
+	FormatVersionTemplate FormatVersion = 2
+)
+
+type CommandChangeType string
+
+const (
+	CommandChangeAdd    CommandChangeType = "add"
+	CommandChangeEdit   CommandChangeType = "edit"
+	CommandChangeDelete CommandChangeType = "delete"
+)
+
+type PermissionLevel string
+
+const (
+	PermissionLevelEveryone    PermissionLevel = "everyone"
+	PermissionLevelSubscriber  PermissionLevel = "subscriber"
+	PermissionLevelVIP         PermissionLevel = "vip"
+	PermissionLevelModerator   PermissionLevel = "moderator"
+	PermissionLevelBroadcaster PermissionLevel = "broadcaster"
+)
+
+var AllPermissionLevels = map[string]struct{}{
+	string(PermissionLevelEveryone):    {},
+	string(PermissionLevelSubscriber):  {},
+	string(PermissionLevelVIP):         {},
+	string(PermissionLevelModerator):   {},
+	string(PermissionLevelBroadcaster): {},
+}
+
 type BotUser struct {
 	TwitchUserID    string
 	IsAuthenticated bool
+	LookupEnabled   bool
+	LookupFormat    string
+	AnnounceEnabled bool
+	AnnounceFormat  string
+	Language        string
+	// CommandPrefix is empty if the channel uses the default prefix
+	CommandPrefix string
+	// CommandsPaused turns off all custom commands of the channel without changing them
+	CommandsPaused bool
 }
 
 type EventSubSubscription struct {
@@ -37,30 +96,161 @@ type EventSubSubscription struct {
 	Topic          string
 }
 
+// RLAccount is stored as part of command snapshots in the command history, so the json keys must not change
+type RLAccount struct {
+	Platform RLPlatform `json:"Platform"`
+	Username string     `json:"Username"`
+}
+
+// BotCommand is stored as a jsonb snapshot in the command history, so the json keys must not change
 type BotCommand struct {
+	CommandName            string             `json:"CommandName"`
+	CommandCooldownSeconds int                `json:"CommandCooldownSeconds"`
+	UserCooldownSeconds    int                `json:"UserCooldownSeconds"`
+	CooldownFeedback       CooldownFeedback   `json:"CooldownFeedback"`
+	MessageFormat          string             `json:"MessageFormat"`
+	FormatVersion          FormatVersion      `json:"FormatVersion"`
+	TwitchUserID           string             `json:"TwitchUserID"`
+	TwitchResponseType     TwitchResponseType `json:"TwitchResponseType"`
+	CommandType            CommandType        `json:"CommandType"`
+	PermissionLevel        PermissionLevel    `json:"PermissionLevel"`
+	RLAccounts             []RLAccount        `json:"RLAccounts"`
+	Aliases                []string           `json:"Aliases"`
+	Enabled                bool               `json:"Enabled"`
+}
+
+// CommandAuthor identifies the chatter that changed a command
+type CommandAuthor struct {
+	TwitchUserID    string
+	TwitchUserLogin string
+}
+
+// AdminCLIAuthorID is the TwitchUserID of changes made through the admin CLI, Twitch user IDs are numeric so it can
+// not be mistaken for a chatter
+const AdminCLIAuthorID = "admin-cli"
+
+// CommandHistoryEntry is a single change of a command, versioned per command name
+type CommandHistoryEntry struct {
+	TwitchUserID string
+	CommandName  string
+	Version      int
+	ChangeType   CommandChangeType
+	ChangedBy    CommandAuthor
+	ChangedAt    time.Time
+	// PreviousCommand is nil if the change added the command
+	PreviousCommand *BotCommand
+	// Command is nil if the change deleted the command
+	Command *BotCommand
+	// UndoneAt is nil unless the change was undone
+	UndoneAt      *time.Time
+	UndoneByLogin *string
+}
+
+// APIKey grants access to the HTTP API for a single channel. Only the SHA-256 hash of the key is stored.
+type APIKey struct {
+	TwitchUserID string
+	KeyHash      string
+	CreatedBy    CommandAuthor
+	CreatedAt    time.Time
+}
+
+type CachedCommand struct {
 	CommandName            string
 	CommandCooldownSeconds int
+	UserCooldownSeconds    int
+	CooldownFeedback       CooldownFeedback
 	MessageFormat          string
-	TwitchUserID           string
+	FormatVersion          FormatVersion
 	TwitchResponseType     TwitchResponseType
-	RLPlatform             RLPlatform
-	RLUsername             string
+	CommandType            CommandType
+	PermissionLevel        PermissionLevel
+	RLAccounts             []RLAccount
+	// RankEmotes are the rank emotes of the channel, cached with the command so rendering needs no DB lookup
+	RankEmotes map[int]string
+	// Disabled is inverted so entries cached before commands could be turned off stay usable
+	Disabled bool
+	// Paused is set if all custom commands of the channel are paused
+	Paused bool
 }
 
-type CachedCommand struct {
-	CommandCooldownSeconds   int
-	NextExecutionAllowedTime time.Time
-	MessageFormat            string
-	TwitchResponseType       TwitchResponseType
-	RLPlatform               RLPlatform
-	RLUsername               string
+// CachedChannelSettings holds the settings needed to recognize commands in every chat message of a channel
+type CachedChannelSettings struct {
+	// CommandPrefix is empty if the channel uses the default prefix
+	CommandPrefix string
+	// KeywordTriggers maps lowercase keywords to the commands they execute
+	KeywordTriggers map[string]string
+	// Language is empty if the channel uses the default language
+	Language string
+}
+
+type StreamSession struct {
+	TwitchUserID string
+	StartedAt    time.Time
+	Ranks        []StreamSessionRank
+}
+
+type StreamSessionRank struct {
+	Account  RLAccount
+	Playlist int32
+	StartMMR int
+	LastMMR  int
+	Wins     int
+	Losses   int
+}
+
+type DivisionThreshold struct {
+	Playlist int32
+	Rank     int
+	Division int
+	MMR      int
+}
+
+type RankObservation struct {
+	Account    RLAccount
+	Playlist   int32
+	MMR        int
+	Rank       int
+	Division   int
+	ObservedAt time.Time
+}
+
+type RankPeak struct {
+	Account      RLAccount
+	Playlist     int32
+	Season       int
+	PeakMMR      int
+	PeakRank     int
+	PeakDivision int
+}
+
+type KnownRank struct {
+	Rank     int
+	Division int
+}
+
+type LeaderboardEntry struct {
+	ChannelID       string
+	TwitchUserID    string
+	TwitchUserLogin string
+	Account         RLAccount
+}
+
+type LeaderboardRanking struct {
+	TwitchUserLogin string
+	MMR             int
+	Rank            int
+	Division        int
 }
 
 // CachedAppState This is currently not safe against race conditions and should be reworked if sharding is to be implemented
 type CachedAppState struct {
-	TwitchAppToken               string
-	TwitchAppTokenExpiry         time.Time
-	TwitchBotAccountToken        string
-	TwitchBotAccountTokenExpiry  time.Time
-	TwitchBotAccountRefreshToken string
+	TwitchAppToken       string
+	TwitchAppTokenExpiry time.Time
+}
+
+// CachedBotUserToken is cached under its own key, so refreshing the app token can not overwrite a new bot user token
+type CachedBotUserToken struct {
+	AccessToken  string
+	Expiry       time.Time
+	RefreshToken string
 }
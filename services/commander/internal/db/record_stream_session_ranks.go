@@ -0,0 +1,45 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+)
+
+// RecordStreamSessionRanks applies observed MMRs to the stream session of a channel and returns the updated session
+// ranks in the order of the observations. The first observation of a rank becomes its baseline, every later MMR change
+// is counted as wins or losses of about mmrPerGame each. The change is computed by the database against the stored row,
+// so concurrent observations can not count the same change twice.
+func (m *mainDB) RecordStreamSessionRanks(ctx context.Context, twitchUserID string, observations []RankObservation, mmrPerGame int) ([]StreamSessionRank, error) {
+	batch := pgx.Batch{}
+	for _, observation := range observations {
+		batch.Queue("insert into "+
+			"stream_session_ranks "+
+			"(twitch_user_id, rl_platform, rl_username, playlist, start_mmr, last_mmr, wins, losses) "+
+			"values "+
+			"($1, $2, $3, $4, $5, $5, 0, 0) "+
+			"on conflict (twitch_user_id, rl_platform, rl_username, playlist) do update "+
+			"set "+
+			"wins = stream_session_ranks.wins + case when excluded.last_mmr > stream_session_ranks.last_mmr "+
+			"then greatest(1, round(abs(excluded.last_mmr - stream_session_ranks.last_mmr)::numeric / $6))::int else 0 end, "+
+			"losses = stream_session_ranks.losses + case when excluded.last_mmr < stream_session_ranks.last_mmr "+
+			"then greatest(1, round(abs(excluded.last_mmr - stream_session_ranks.last_mmr)::numeric / $6))::int else 0 end, "+
+			"last_mmr = excluded.last_mmr "+
+			"returning start_mmr, last_mmr, wins, losses;",
+			twitchUserID, observation.Account.Platform, observation.Account.Username, observation.Playlist,
+			observation.MMR, mmrPerGame)
+	}
+
+	results := m.dbPool.SendBatch(ctx, &batch)
+	defer results.Close()
+
+	ranks := make([]StreamSessionRank, len(observations))
+	for i, observation := range observations {
+		ranks[i] = StreamSessionRank{Account: observation.Account, Playlist: observation.Playlist}
+		err := results.QueryRow().Scan(&ranks[i].StartMMR, &ranks[i].LastMMR, &ranks[i].Wins, &ranks[i].Losses)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return ranks, results.Close()
+}
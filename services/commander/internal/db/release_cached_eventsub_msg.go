@@ -0,0 +1,10 @@
+package db
+
+import "context"
+
+// ReleaseCachedEventSubMsg removes the mark of an EventSub message, so a retry of a message that could not be handled
+// is handled again
+func (c *cacheDB) ReleaseCachedEventSubMsg(ctx context.Context, messageID string) error {
+	cacheKey := cachePrefixEventSubMsg + ":" + messageID
+	return c.client.Del(ctx, cacheKey).Err()
+}
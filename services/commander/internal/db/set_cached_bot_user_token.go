@@ -0,0 +1,19 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+// SetCachedBotUserToken caches the token until its access token expires, the refresh token is persisted in the main db
+
+func (c *cacheDB) SetCachedBotUserToken(ctx context.Context, cachedBotUserToken CachedBotUserToken) error {
+	jsonBytes, err := json.Marshal(cachedBotUserToken)
+	if err != nil {
+		return err
+	}
+
+	err = c.client.Set(ctx, cacheKeyBotUserToken, string(jsonBytes), time.Until(cachedBotUserToken.Expiry)).Err()
+	return err
+}
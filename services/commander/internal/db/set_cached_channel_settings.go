@@ -0,0 +1,19 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+func (c *cacheDB) SetCachedChannelSettings(ctx context.Context, channelID string, settings *CachedChannelSettings, ttl time.Duration) error {
+	cacheKey := cachePrefixChannelSettings + ":" + channelID
+
+	jsonBytes, err := json.Marshal(settings)
+	if err != nil {
+		return err
+	}
+
+	err = c.client.Set(ctx, cacheKey, string(jsonBytes), ttl).Err()
+	return err
+}
@@ -0,0 +1,19 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+func (c *cacheDB) SetCachedKnownRanks(ctx context.Context, channelID string, account RLAccount, ranks map[int32]KnownRank, ttl time.Duration) error {
+	cacheKey := cachePrefixKnownRanks + ":" + channelID + ":" + string(account.Platform) + ":" + account.Username
+
+	jsonBytes, err := json.Marshal(ranks)
+	if err != nil {
+		return err
+	}
+
+	err = c.client.Set(ctx, cacheKey, string(jsonBytes), ttl).Err()
+	return err
+}
@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+	"time"
+)
+
+// StartStreamSession replaces any previous session of the user, discarding its rank snapshots
+func (m *mainDB) StartStreamSession(ctx context.Context, twitchUserID string, startedAt time.Time) error {
+	tx, err := m.dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, "delete from "+
+		"stream_sessions "+
+		"where "+
+		"twitch_user_id = $1;", twitchUserID)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(ctx, "insert into "+
+		"stream_sessions "+
+		"(twitch_user_id, started_at) "+
+		"values "+
+		"($1, $2);", twitchUserID, startedAt)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
@@ -0,0 +1,102 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"github.com/jackc/pgx/v5"
+)
+
+// UndoCommandChange restores a command to its state before the latest change that has not been undone yet. The undone
+// change is returned, or false if there is nothing left to undo.
+func (m *mainDB) UndoCommandChange(ctx context.Context, channelID string, commandName string, undoneBy CommandAuthor) (*CommandHistoryEntry, bool, error) {
+	tx, err := m.dbPool.Begin(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+	defer tx.Rollback(ctx)
+
+	err = lockCommandHistory(ctx, tx, channelID, commandName)
+	if err != nil {
+		return nil, false, err
+	}
+
+	e := CommandHistoryEntry{}
+	err = tx.QueryRow(ctx, selectCommandHistory+
+		"where "+
+		"twitch_user_id = $1 "+
+		"and command_name = $2 "+
+		"and undone_at is null "+
+		"order by version desc "+
+		"limit 1 "+
+		"for update;",
+		channelID, commandName).Scan(&e.TwitchUserID, &e.CommandName, &e.Version, &e.ChangeType, &e.ChangedBy.TwitchUserID,
+		&e.ChangedBy.TwitchUserLogin, &e.ChangedAt, &e.PreviousCommand, &e.Command, &e.UndoneAt, &e.UndoneByLogin)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, false, nil
+		}
+		return nil, false, err
+	}
+
+	if e.PreviousCommand == nil {
+		_, err = tx.Exec(ctx, "delete from "+
+			"bot_commands "+
+			"where "+
+			"twitch_user_id = $1 "+
+			"and command_name = $2;",
+			channelID, commandName)
+	} else {
+		err = restoreCommand(ctx, tx, e.PreviousCommand)
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	_, err = tx.Exec(ctx, "update "+
+		"bot_command_history "+
+		"set "+
+		"(undone_at, undone_by_login) = (now(), $4) "+
+		"where "+
+		"twitch_user_id = $1 "+
+		"and command_name = $2 "+
+		"and version = $3;",
+		channelID, commandName, e.Version, undoneBy.TwitchUserLogin)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return &e, true, nil
+}
+
+// restoreCommand brings back a command snapshot, recreating the command and its aliases if it was deleted
+func restoreCommand(ctx context.Context, tx pgx.Tx, cmd *BotCommand) error {
+	updated, err := updateCommand(ctx, tx, cmd)
+	if err != nil || updated {
+		return err
+	}
+
+	err = insertCommand(ctx, tx, cmd)
+	if err != nil {
+		return err
+	}
+
+	// Aliases that were taken by another command in the meantime stay with that command
+	for _, alias := range cmd.Aliases {
+		_, err = tx.Exec(ctx, "insert into "+
+			"bot_command_aliases "+
+			"(twitch_user_id, alias_name, command_name) "+
+			"values "+
+			"($1, $2, $3) "+
+			"on conflict do nothing;",
+			cmd.TwitchUserID, alias, cmd.CommandName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
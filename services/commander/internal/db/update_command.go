@@ -1,21 +1,65 @@
 package db
 
-import "context"
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+)
 
-func (m *mainDB) UpdateCommand(ctx context.Context, cmd *BotCommand) error {
-	res, err := m.dbPool.Query(ctx, "update "+
+func (m *mainDB) UpdateCommand(ctx context.Context, cmd *BotCommand, changedBy CommandAuthor) error {
+	tx, err := m.dbPool.Begin(ctx)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback(ctx)
+
+	previous, err := findCommandSnapshot(ctx, tx, cmd.TwitchUserID, cmd.CommandName)
+	if err != nil {
+		return err
+	}
+
+	_, err = updateCommand(ctx, tx, cmd)
+	if err != nil {
+		return err
+	}
+
+	err = addCommandHistory(ctx, tx, cmd.TwitchUserID, cmd.CommandName, CommandChangeEdit, previous, cmd, changedBy)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+// updateCommand overwrites a command with cmd and reports whether the command existed
+func updateCommand(ctx context.Context, tx pgx.Tx, cmd *BotCommand) (bool, error) {
+	tag, err := tx.Exec(ctx, "update "+
 		"bot_commands "+
 		"set "+
-		"(command_cooldown_seconds, message_format, twitch_response_type, rl_platform, rl_username) = ($1, $2, $3, $4, $5) "+
+		"(command_cooldown_seconds, message_format, twitch_response_type, command_type, permission_level, "+
+		"user_cooldown_seconds, cooldown_feedback, format_version, enabled) = ($1, $2, $3, $4, $5, $6, $7, $8, $9) "+
 		"where "+
-		"twitch_user_id = $6 "+
-		"and command_name = $7;",
-		cmd.CommandCooldownSeconds, cmd.MessageFormat, cmd.TwitchResponseType,
-		cmd.RLPlatform, cmd.RLUsername, cmd.TwitchUserID, cmd.CommandName)
+		"twitch_user_id = $10 "+
+		"and command_name = $11;",
+		cmd.CommandCooldownSeconds, cmd.MessageFormat, cmd.TwitchResponseType, cmd.CommandType, cmd.PermissionLevel,
+		cmd.UserCooldownSeconds, cmd.CooldownFeedback, cmd.FormatVersion, cmd.Enabled,
+		cmd.TwitchUserID, cmd.CommandName)
+	if err != nil {
+		return false, err
+	}
+	if tag.RowsAffected() == 0 {
+		return false, nil
+	}
 
-	if err == nil {
-		res.Close()
+	// Accounts are rewritten as a whole to keep their indices contiguous
+	_, err = tx.Exec(ctx, "delete from "+
+		"bot_command_accounts "+
+		"where "+
+		"twitch_user_id = $1 "+
+		"and command_name = $2;",
+		cmd.TwitchUserID, cmd.CommandName)
+	if err != nil {
+		return false, err
 	}
 
-	return err
+	return true, insertCommandAccounts(ctx, tx, cmd)
 }
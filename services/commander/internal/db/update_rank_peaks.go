@@ -0,0 +1,31 @@
+package db
+
+import (
+	"context"
+	"github.com/jackc/pgx/v5"
+)
+
+// UpdateRankPeaks raises the stored peak MMR and peak rank of every account, playlist and season to the given values
+// if they are higher
+func (m *mainDB) UpdateRankPeaks(ctx context.Context, peaks []RankPeak) error {
+	batch := pgx.Batch{}
+	for _, peak := range peaks {
+		batch.Queue("insert into "+
+			"rank_peaks "+
+			"(rl_platform, rl_username, playlist, season, peak_mmr, peak_rank, peak_division, updated_at) "+
+			"values "+
+			"($1, $2, $3, $4, $5, $6, $7, now()) "+
+			"on conflict (rl_platform, rl_username, playlist, season) do update "+
+			"set "+
+			"peak_mmr = greatest(rank_peaks.peak_mmr, excluded.peak_mmr), "+
+			"peak_rank = case when (excluded.peak_rank, excluded.peak_division) > (rank_peaks.peak_rank, rank_peaks.peak_division) "+
+			"then excluded.peak_rank else rank_peaks.peak_rank end, "+
+			"peak_division = case when (excluded.peak_rank, excluded.peak_division) > (rank_peaks.peak_rank, rank_peaks.peak_division) "+
+			"then excluded.peak_division else rank_peaks.peak_division end, "+
+			"updated_at = now();",
+			peak.Account.Platform, peak.Account.Username, peak.Playlist, peak.Season, peak.PeakMMR, peak.PeakRank,
+			peak.PeakDivision)
+	}
+
+	return m.dbPool.SendBatch(ctx, &batch).Close()
+}
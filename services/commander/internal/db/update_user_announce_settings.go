@@ -0,0 +1,19 @@
+package db
+
+import "context"
+
+func (m *mainDB) UpdateUserAnnounceSettings(ctx context.Context, twitchUserID string, enabled bool, format string) error {
+	res, err := m.dbPool.Query(ctx, "update "+
+		"bot_users "+
+		"set "+
+		"(announce_enabled, announce_format) = ($1, $2) "+
+		"where "+
+		"twitch_user_id = $3;",
+		enabled, format, twitchUserID)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
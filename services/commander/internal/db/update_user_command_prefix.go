@@ -0,0 +1,19 @@
+package db
+
+import "context"
+
+func (m *mainDB) UpdateUserCommandPrefix(ctx context.Context, twitchUserID string, prefix string) error {
+	res, err := m.dbPool.Query(ctx, "update "+
+		"bot_users "+
+		"set "+
+		"command_prefix = $1 "+
+		"where "+
+		"twitch_user_id = $2;",
+		prefix, twitchUserID)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
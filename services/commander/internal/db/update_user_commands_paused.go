@@ -0,0 +1,19 @@
+package db
+
+import "context"
+
+func (m *mainDB) UpdateUserCommandsPaused(ctx context.Context, twitchUserID string, paused bool) error {
+	res, err := m.dbPool.Query(ctx, "update "+
+		"bot_users "+
+		"set "+
+		"commands_paused = $1 "+
+		"where "+
+		"twitch_user_id = $2;",
+		paused, twitchUserID)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
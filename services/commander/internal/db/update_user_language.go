@@ -0,0 +1,19 @@
+package db
+
+import "context"
+
+func (m *mainDB) UpdateUserLanguage(ctx context.Context, twitchUserID string, language string) error {
+	res, err := m.dbPool.Query(ctx, "update "+
+		"bot_users "+
+		"set "+
+		"language = $1 "+
+		"where "+
+		"twitch_user_id = $2;",
+		language, twitchUserID)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
@@ -0,0 +1,23 @@
+package db
+
+import "context"
+
+// UpsertAPIKey stores the hash of a channel's API key, replacing the previous key of the channel
+func (m *mainDB) UpsertAPIKey(ctx context.Context, channelID string, keyHash string, createdBy CommandAuthor) error {
+	res, err := m.dbPool.Query(ctx, "insert into "+
+		"api_keys "+
+		"(twitch_user_id, key_hash, created_by_id, created_by_login, created_at) "+
+		"values "+
+		"($1, $2, $3, $4, now()) "+
+		"on conflict (twitch_user_id) do update "+
+		"set "+
+		"(key_hash, created_by_id, created_by_login, created_at) = "+
+		"(excluded.key_hash, excluded.created_by_id, excluded.created_by_login, excluded.created_at);",
+		channelID, keyHash, createdBy.TwitchUserID, createdBy.TwitchUserLogin)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
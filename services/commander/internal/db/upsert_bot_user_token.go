@@ -0,0 +1,23 @@
+package db
+
+import "context"
+
+// UpsertBotUserToken stores the refresh token of the bot account, so it survives the cache being flushed
+func (m *mainDB) UpsertBotUserToken(ctx context.Context, twitchUserID string, refreshToken string) error {
+	res, err := m.dbPool.Query(ctx, "insert into "+
+		"bot_user_tokens "+
+		"(twitch_user_id, refresh_token, updated_at) "+
+		"values "+
+		"($1, $2, now()) "+
+		"on conflict (twitch_user_id) do update "+
+		"set "+
+		"(refresh_token, updated_at) = "+
+		"(excluded.refresh_token, excluded.updated_at);",
+		twitchUserID, refreshToken)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
@@ -0,0 +1,21 @@
+package db
+
+import "context"
+
+func (m *mainDB) UpsertKeywordTrigger(ctx context.Context, channelID string, keyword string, commandName string) error {
+	res, err := m.dbPool.Query(ctx, "insert into "+
+		"keyword_triggers "+
+		"(twitch_user_id, keyword, command_name) "+
+		"values "+
+		"($1, $2, $3) "+
+		"on conflict (twitch_user_id, keyword) do update "+
+		"set "+
+		"command_name = excluded.command_name;",
+		channelID, keyword, commandName)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
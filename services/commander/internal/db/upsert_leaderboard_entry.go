@@ -0,0 +1,21 @@
+package db
+
+import "context"
+
+func (m *mainDB) UpsertLeaderboardEntry(ctx context.Context, entry *LeaderboardEntry) error {
+	res, err := m.dbPool.Query(ctx, "insert into "+
+		"leaderboard_entries "+
+		"(channel_id, twitch_user_id, twitch_user_login, rl_platform, rl_username) "+
+		"values "+
+		"($1, $2, $3, $4, $5) "+
+		"on conflict (channel_id, twitch_user_id) do update "+
+		"set "+
+		"(twitch_user_login, rl_platform, rl_username) = (excluded.twitch_user_login, excluded.rl_platform, excluded.rl_username);",
+		entry.ChannelID, entry.TwitchUserID, entry.TwitchUserLogin, entry.Account.Platform, entry.Account.Username)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
@@ -0,0 +1,21 @@
+package db
+
+import "context"
+
+func (m *mainDB) UpsertRankEmote(ctx context.Context, channelID string, rank int, emote string) error {
+	res, err := m.dbPool.Query(ctx, "insert into "+
+		"rank_emotes "+
+		"(twitch_user_id, rank, emote) "+
+		"values "+
+		"($1, $2, $3) "+
+		"on conflict (twitch_user_id, rank) do update "+
+		"set "+
+		"emote = excluded.emote;",
+		channelID, rank, emote)
+
+	if err == nil {
+		res.Close()
+	}
+
+	return err
+}
@@ -10,7 +10,11 @@ import (
 
 var (
 	tokenMatcher          = regexp.MustCompile("\\$\\(((\\w|\\.)+)\\)")
-	tokenExtractor        = regexp.MustCompile("^([u123hrdst])\\.([rdm])\\.?([sml])?$")
+	tokenExtractor        = regexp.MustCompile("^([u123hrdst])\\.(md|wl|[pa][rdm]|[rdm])\\.?([smle])?$")
+	accountExtractor      = regexp.MustCompile("^(acc([1-9])|maxrank|maxmmr)\\.(.+)$")
+	argExtractor          = regexp.MustCompile("^arg([1-9])$")
+	sessionTokenMatcher   = regexp.MustCompile("\\$\\((\\w+\\.)?[u123hrdst]\\.(md|wl)\\)")
+	peakTokenMatcher      = regexp.MustCompile("\\$\\((\\w+\\.)?[u123hrdst]\\.[pa][rdm](\\.?[smle])?\\)")
 	playlistAbbreviations = map[string]trackerggscraper.RankPlaylist{
 		"u": trackerggscraper.RankPlaylist_UNRANKED,
 		"1": trackerggscraper.RankPlaylist_RANKED_1V1,
@@ -24,6 +28,37 @@ var (
 		"t": trackerggscraper.RankPlaylist_TOURNAMENTS,
 		"b": trackerggscraper.RankPlaylist_HEATSEEKER,
 	}
+	playlistArgs = map[string]trackerggscraper.RankPlaylist{
+		"casual":      trackerggscraper.RankPlaylist_UNRANKED,
+		"1v1":         trackerggscraper.RankPlaylist_RANKED_1V1,
+		"2v2":         trackerggscraper.RankPlaylist_RANKED_2V2,
+		"3v3":         trackerggscraper.RankPlaylist_RANKED_3V3,
+		"4v4":         trackerggscraper.RankPlaylist_RANKED_4V4,
+		"hoops":       trackerggscraper.RankPlaylist_HOOPS,
+		"rumble":      trackerggscraper.RankPlaylist_RUMBLE,
+		"dropshot":    trackerggscraper.RankPlaylist_DROPSHOT,
+		"snowday":     trackerggscraper.RankPlaylist_SNOWDAY,
+		"tournaments": trackerggscraper.RankPlaylist_TOURNAMENTS,
+		"heatseeker":  trackerggscraper.RankPlaylist_HEATSEEKER,
+	}
+	comparisonPlaylists = []trackerggscraper.RankPlaylist{
+		trackerggscraper.RankPlaylist_RANKED_1V1,
+		trackerggscraper.RankPlaylist_RANKED_2V2,
+		trackerggscraper.RankPlaylist_RANKED_3V3,
+	}
+	playlistNames = map[trackerggscraper.RankPlaylist]string{
+		trackerggscraper.RankPlaylist_UNRANKED:    "Casual",
+		trackerggscraper.RankPlaylist_RANKED_1V1:  "Ranked 1v1",
+		trackerggscraper.RankPlaylist_RANKED_2V2:  "Ranked 2v2",
+		trackerggscraper.RankPlaylist_RANKED_3V3:  "Ranked 3v3",
+		trackerggscraper.RankPlaylist_RANKED_4V4:  "Ranked 4v4",
+		trackerggscraper.RankPlaylist_HOOPS:       "Hoops",
+		trackerggscraper.RankPlaylist_RUMBLE:      "Rumble",
+		trackerggscraper.RankPlaylist_DROPSHOT:    "Dropshot",
+		trackerggscraper.RankPlaylist_SNOWDAY:     "Snow Day",
+		trackerggscraper.RankPlaylist_TOURNAMENTS: "Tournaments",
+		trackerggscraper.RankPlaylist_HEATSEEKER:  "Heatseeker",
+	}
 	ranksS = map[int]string{
 		0: "UR", 1: "B1", 2: "B2", 3: "B3", 4: "S1", 5: "S2", 6: "S3", 7: "G1", 8: "G2", 9: "G3",
 		10: "P1", 11: "P2", 12: "P3", 13: "D1", 14: "D2", 15: "D3",
@@ -46,7 +81,65 @@ var (
 	}
 )
 
-func FormatRankString(rankData *trackerggscraper.PlayerCurrentRanksRes, formatString string) string {
+// AccountData holds everything that can be rendered for a single Rocket League account
+type AccountData struct {
+	Ranks *trackerggscraper.PlayerCurrentRanksRes
+	// Session is nil if the channel is not live
+	Session map[trackerggscraper.RankPlaylist]SessionStats
+	// Peaks is nil if the format does not use peak tokens
+	Peaks map[trackerggscraper.RankPlaylist]PeakStats
+}
+
+// SessionStats describes the changes in a playlist since the channel went live
+type SessionStats struct {
+	MMRDelta int
+	Wins     int
+	Losses   int
+}
+
+// PeakStats holds the highest values reached in a playlist, either in the current season or of all time
+type PeakStats struct {
+	SeasonMMR       int
+	SeasonRank      int
+	SeasonDivision  int
+	AllTimeMMR      int
+	AllTimeRank     int
+	AllTimeDivision int
+}
+
+// Invocation carries the chat message a format is rendered for
+type Invocation struct {
+	// Args are the words following the command name
+	Args    []string
+	Sender  string
+	Channel string
+	// RankEmotes are the emotes of the channel by rank tier, used by the e modifier
+	RankEmotes map[int]string
+	// Language selects the language of rank names, English is used if it is empty or has no translation
+	Language string
+}
+
+// UsesSessionTokens reports whether formatString contains tokens that require stream session data
+func UsesSessionTokens(formatString string) bool {
+	return sessionTokenMatcher.MatchString(formatString)
+}
+
+// UsesPeakTokens reports whether formatString contains tokens that require peak data
+func UsesPeakTokens(formatString string) bool {
+	return peakTokenMatcher.MatchString(formatString)
+}
+
+// EscapeTokens prefixes all tokens with a backslash, so a format can be shown in chat without looking like the output of
+// a rank command
+func EscapeTokens(formatString string) string {
+	return tokenMatcher.ReplaceAllString(formatString, `\$$($1)`)
+}
+
+// FormatRankString renders formatString for the given accounts. Tokens refer to the first account unless prefixed
+// with an account selector: accN picks the Nth account, maxrank and maxmmr pick the account with the highest rank
+// or MMR in the token's playlist. The tokens args, argN, sender and channel are filled from invocation, which may be
+// nil if the format is not rendered for a chat message.
+func FormatRankString(accounts []AccountData, invocation *Invocation, formatString string) string {
 	var result strings.Builder
 
 	matchesBytes := tokenMatcher.FindAllStringIndex(formatString, -1)
@@ -68,7 +161,7 @@ func FormatRankString(rankData *trackerggscraper.PlayerCurrentRanksRes, formatSt
 		if i == nextMatch[0] {
 			// insert token for current match
 			token := formatChars[nextMatch[0]+2 : nextMatch[1]-1]
-			result.WriteString(evalToken(rankData, string(token)))
+			result.WriteString(evalToken(accounts, invocation, string(token)))
 		} else if i+1 == nextMatch[1] {
 			// use next match
 			matchIndex++
@@ -89,14 +182,40 @@ func FormatRankString(rankData *trackerggscraper.PlayerCurrentRanksRes, formatSt
 	return result.String()
 }
 
-func evalToken(rankData *trackerggscraper.PlayerCurrentRanksRes, token string) string {
-	if token == "name" {
-		return rankData.DisplayName
+func evalToken(accounts []AccountData, invocation *Invocation, token string) string {
+	if value, ok := evalInvocationToken(invocation, token); ok {
+		return value
+	}
+
+	selector := "acc"
+	accountIndex := 0
+	rankToken := token
+
+	accountMatches := accountExtractor.FindStringSubmatch(token)
+	if accountMatches != nil {
+		if accountMatches[2] != "" {
+			accountIndex, _ = strconv.Atoi(accountMatches[2])
+			accountIndex--
+		} else {
+			selector = accountMatches[1]
+		}
+		rankToken = accountMatches[3]
 	}
 
-	matches := tokenExtractor.FindAllStringSubmatch(token, -1)
+	if selector == "acc" && accountIndex >= len(accounts) {
+		return "[no_data:" + token + "]"
+	}
+
+	if rankToken == "name" {
+		if selector != "acc" {
+			return "$(" + token + ")"
+		}
+		return accounts[accountIndex].Ranks.DisplayName
+	}
+
+	matches := tokenExtractor.FindAllStringSubmatch(rankToken, -1)
 	if len(matches) == 0 {
-		return "$(" + string(token) + ")"
+		return "$(" + token + ")"
 	}
 
 	playlist := playlistAbbreviations[matches[0][1]]
@@ -107,39 +226,240 @@ func evalToken(rankData *trackerggscraper.PlayerCurrentRanksRes, token string) s
 		modifier = "l"
 	}
 
+	switch selector {
+	case "maxrank":
+		accountIndex = findHighestRankingAccount(accounts, playlist, func(a, b *trackerggscraper.PlayerRank) bool {
+			if a.Rank != b.Rank {
+				return a.Rank > b.Rank
+			}
+			if a.Division != b.Division {
+				return a.Division > b.Division
+			}
+			return a.Mmr > b.Mmr
+		})
+	case "maxmmr":
+		accountIndex = findHighestRankingAccount(accounts, playlist, func(a, b *trackerggscraper.PlayerRank) bool {
+			return a.Mmr > b.Mmr
+		})
+	}
+
+	if accountIndex < 0 {
+		return "[no_data:" + token + "]"
+	}
+	ranking := findRanking(accounts[accountIndex].Ranks, playlist)
+	if ranking == nil {
+		return "[no_data:" + token + "]"
+	}
+
+	if stat[0] == 'p' || stat[0] == 'a' {
+		peakStats, ok := accounts[accountIndex].Peaks[playlist]
+		if !ok {
+			return "[no_data:" + token + "]"
+		}
+		switch stat {
+		case "pr":
+			return rankNameOrEmote(invocation, peakStats.SeasonRank, modifier)
+		case "pd":
+			return divisionToStr(peakStats.SeasonDivision, modifier)
+		case "pm":
+			return strconv.Itoa(peakStats.SeasonMMR)
+		case "ar":
+			return rankNameOrEmote(invocation, peakStats.AllTimeRank, modifier)
+		case "ad":
+			return divisionToStr(peakStats.AllTimeDivision, modifier)
+		}
+		return strconv.Itoa(peakStats.AllTimeMMR)
+	}
+
+	switch stat {
+	case "r":
+		return rankNameOrEmote(invocation, int(ranking.Rank), modifier)
+	case "d":
+		return divisionToStr(int(ranking.Division), modifier)
+	case "md":
+		// Channels that are not live have no session and therefore no changes
+		return signedIntToStr(accounts[accountIndex].Session[playlist].MMRDelta)
+	case "wl":
+		sessionStats := accounts[accountIndex].Session[playlist]
+		return strconv.Itoa(sessionStats.Wins) + "W/" + strconv.Itoa(sessionStats.Losses) + "L"
+	}
+	return strconv.Itoa(int(ranking.Mmr))
+}
+
+// FormatComparison renders the ranks of two players next to each other, followed by the MMR difference of the first
+// player to the second in every playlist both have played
+func FormatComparison(first *trackerggscraper.PlayerCurrentRanksRes, second *trackerggscraper.PlayerCurrentRanksRes) string {
+	var result strings.Builder
+
+	result.WriteString(first.DisplayName + " vs " + second.DisplayName)
+	for _, playlist := range comparisonPlaylists {
+		firstRanking := findRanking(first, playlist)
+		secondRanking := findRanking(second, playlist)
+
+		result.WriteString(" | " + playlistNames[playlist] + ": ")
+		result.WriteString(comparisonRankingToStr(firstRanking) + " vs " + comparisonRankingToStr(secondRanking))
+		if firstRanking != nil && secondRanking != nil {
+			result.WriteString(" [" + signedIntToStr(int(firstRanking.Mmr-secondRanking.Mmr)) + "]")
+		}
+	}
+
+	return result.String()
+}
+
+func comparisonRankingToStr(ranking *trackerggscraper.PlayerRank) string {
+	if ranking == nil {
+		return "-"
+	}
+	return ShortRankName(int(ranking.Rank), int(ranking.Division)) + " (" + strconv.Itoa(int(ranking.Mmr)) + ")"
+}
+
+func findRanking(rankData *trackerggscraper.PlayerCurrentRanksRes, playlist trackerggscraper.RankPlaylist) *trackerggscraper.PlayerRank {
 	for _, ranking := range rankData.Ranks {
 		if playlist == ranking.Playlist {
-			if stat == "r" {
-				return rankToStr(int(ranking.Rank), modifier)
-			} else if stat == "d" {
-				if modifier == "l" || modifier == "m" {
-					return toRoman(int(ranking.Division + 1))
-				} else {
-					return strconv.Itoa(int(ranking.Division + 1))
-				}
-			} else if stat == "m" {
-				return strconv.Itoa(int(ranking.Mmr))
-			}
+			return ranking
+		}
+	}
+	return nil
+}
+
+// findHighestRankingAccount returns the index of the account with the highest ranking in the given playlist as
+// ordered by isHigher, or -1 if no account has a ranking in that playlist
+func findHighestRankingAccount(accounts []AccountData, playlist trackerggscraper.RankPlaylist, isHigher func(a, b *trackerggscraper.PlayerRank) bool) int {
+	highestIndex := -1
+	var highest *trackerggscraper.PlayerRank
+	for i, account := range accounts {
+		ranking := findRanking(account.Ranks, playlist)
+		if ranking != nil && (highest == nil || isHigher(ranking, highest)) {
+			highestIndex = i
+			highest = ranking
+		}
+	}
+	return highestIndex
+}
+
+// RankName returns the long name of a rank including its division in the given language, e.g. "Diamond II Div III"
+func RankName(rank int, division int, language string) string {
+	if rank == 0 {
+		return rankToStr(0, "l", language)
+	}
+	return rankToStr(rank, "l", language) + " Div " + divisionToStr(division, "l")
+}
+
+// ShortRankName returns the short name of a rank including its division, e.g. "D2 III"
+func ShortRankName(rank int, division int) string {
+	if rank == 0 {
+		return ranksS[0]
+	}
+	return rankToStr(rank, "s", "") + " " + divisionToStr(division, "l")
+}
+
+// ParsePlaylist resolves a playlist from its name as typed in chat (e.g. "2v2") or its token abbreviation (e.g. "2")
+func ParsePlaylist(arg string) (trackerggscraper.RankPlaylist, bool) {
+	arg = strings.ToLower(arg)
+	if playlist, ok := playlistArgs[arg]; ok {
+		return playlist, true
+	}
+	playlist, ok := playlistAbbreviations[arg]
+	return playlist, ok
+}
+
+// PlaylistName returns the display name of a playlist
+func PlaylistName(playlist trackerggscraper.RankPlaylist, language string) string {
+	if name, ok := localizedPlaylistNames[language][playlist]; ok {
+		return name
+	}
+	if name, ok := playlistNames[playlist]; ok {
+		return name
+	}
+	return playlist.String()
+}
+
+// ShortRankTierName returns the short name of a rank without its division, e.g. "GC1"
+func ShortRankTierName(rank int) string {
+	return rankToStr(rank, "s", "")
+}
+
+// ParseRank resolves a rank tier from its number or its short name as typed in chat, e.g. "GC1"
+func ParseRank(arg string) (int, bool) {
+	if rank, err := strconv.Atoi(arg); err == nil {
+		return rank, rank >= 0 && rank < len(ranksS)
+	}
+	for rank, name := range ranksS {
+		if strings.EqualFold(name, arg) {
+			return rank, true
 		}
 	}
+	return 0, false
+}
 
-	return "[no_data:" + token + "]"
+// rankNameOrEmote renders a rank with the given modifier. The e modifier renders the channel's emote for the rank and
+// falls back to the long rank name if there is none.
+func rankNameOrEmote(invocation *Invocation, rank int, modifier string) string {
+	if modifier != "e" {
+		return rankToStr(rank, modifier, invocationLanguage(invocation))
+	}
+	if invocation != nil {
+		if emote, ok := invocation.RankEmotes[rank]; ok {
+			return emote
+		}
+	}
+	return rankToStr(rank, "l", invocationLanguage(invocation))
 }
 
-func rankToStr(rank int, modifier string) string {
+func rankToStr(rank int, modifier string, language string) string {
 	if rank > 22 {
 		return "?"
 	}
 	if modifier == "s" {
 		return ranksS[rank]
 	} else if modifier == "m" {
-		return ranksM[rank]
+		return localizedRankName(ranksM, localizedRanksM, rank, language)
 	} else if modifier == "l" {
-		return ranksL[rank]
+		return localizedRankName(ranksL, localizedRanksL, rank, language)
 	}
 	return "??"
 }
 
+func divisionToStr(division int, modifier string) string {
+	if modifier == "s" {
+		return strconv.Itoa(division + 1)
+	}
+	return toRoman(division + 1)
+}
+
+func evalInvocationToken(invocation *Invocation, token string) (string, bool) {
+	if invocation == nil {
+		return "", false
+	}
+
+	switch token {
+	case "args":
+		return strings.Join(invocation.Args, " "), true
+	case "sender":
+		return invocation.Sender, true
+	case "channel":
+		return invocation.Channel, true
+	}
+
+	argMatches := argExtractor.FindStringSubmatch(token)
+	if argMatches == nil {
+		return "", false
+	}
+	argIndex, _ := strconv.Atoi(argMatches[1])
+	if argIndex > len(invocation.Args) {
+		// missing arguments are left empty so formats still read naturally without them
+		return "", true
+	}
+	return invocation.Args[argIndex-1], true
+}
+
+func signedIntToStr(num int) string {
+	if num < 0 {
+		return strconv.Itoa(num)
+	}
+	return "+" + strconv.Itoa(num)
+}
+
 func toRoman(num int) string {
 	switch num {
 	case 1:
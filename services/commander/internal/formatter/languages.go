@@ -0,0 +1,96 @@
+package formatter
+
+import "RocketRankBot/services/commander/rpc/trackerggscraper"
+
+var (
+	// localizedRanksM and localizedRanksL hold the medium and long rank names by language. Short rank names are the same
+	// in every language, and languages without a translation use the English names.
+	localizedRanksM = map[string]map[int]string{
+		"de": {
+			0: "Ungewertet", 1: "Bronze I", 2: "Bronze II", 3: "Bronze III",
+			4: "Silber I", 5: "Silber II", 6: "Silber III", 7: "Gold I", 8: "Gold II", 9: "Gold III",
+			10: "Platin I", 11: "Platin II", 12: "Platin III", 13: "Dia I", 14: "Dia II", 15: "Dia III",
+			16: "Champ I", 17: "Champ II", 18: "Champ III", 19: "Grand Champ I", 20: "Grand Champ II",
+			21: "Grand Champ III", 22: "SSL",
+		},
+		"es": {
+			0: "Sin rango", 1: "Bronce I", 2: "Bronce II", 3: "Bronce III",
+			4: "Plata I", 5: "Plata II", 6: "Plata III", 7: "Oro I", 8: "Oro II", 9: "Oro III",
+			10: "Plat I", 11: "Plat II", 12: "Plat III", 13: "Diam I", 14: "Diam II", 15: "Diam III",
+			16: "Camp I", 17: "Camp II", 18: "Camp III", 19: "Gran Camp I", 20: "Gran Camp II",
+			21: "Gran Camp III", 22: "SSL",
+		},
+		"fr": {
+			0: "Non classé", 1: "Bronze I", 2: "Bronze II", 3: "Bronze III",
+			4: "Argent I", 5: "Argent II", 6: "Argent III", 7: "Or I", 8: "Or II", 9: "Or III",
+			10: "Plat I", 11: "Plat II", 12: "Plat III", 13: "Diam I", 14: "Diam II", 15: "Diam III",
+			16: "Champ I", 17: "Champ II", 18: "Champ III", 19: "Grand Champ I", 20: "Grand Champ II",
+			21: "Grand Champ III", 22: "SSL",
+		},
+	}
+	// localizedPlaylistNames holds the playlist names that differ from the English ones by language
+	localizedPlaylistNames = map[string]map[trackerggscraper.RankPlaylist]string{
+		"de": {
+			trackerggscraper.RankPlaylist_UNRANKED:    "Zwanglos",
+			trackerggscraper.RankPlaylist_RANKED_1V1:  "Gewertet 1v1",
+			trackerggscraper.RankPlaylist_RANKED_2V2:  "Gewertet 2v2",
+			trackerggscraper.RankPlaylist_RANKED_3V3:  "Gewertet 3v3",
+			trackerggscraper.RankPlaylist_RANKED_4V4:  "Gewertet 4v4",
+			trackerggscraper.RankPlaylist_TOURNAMENTS: "Turniere",
+		},
+		"es": {
+			trackerggscraper.RankPlaylist_RANKED_1V1:  "Competitivo 1v1",
+			trackerggscraper.RankPlaylist_RANKED_2V2:  "Competitivo 2v2",
+			trackerggscraper.RankPlaylist_RANKED_3V3:  "Competitivo 3v3",
+			trackerggscraper.RankPlaylist_RANKED_4V4:  "Competitivo 4v4",
+			trackerggscraper.RankPlaylist_TOURNAMENTS: "Torneos",
+		},
+		"fr": {
+			trackerggscraper.RankPlaylist_UNRANKED:    "Occasionnel",
+			trackerggscraper.RankPlaylist_RANKED_1V1:  "Classé 1v1",
+			trackerggscraper.RankPlaylist_RANKED_2V2:  "Classé 2v2",
+			trackerggscraper.RankPlaylist_RANKED_3V3:  "Classé 3v3",
+			trackerggscraper.RankPlaylist_RANKED_4V4:  "Classé 4v4",
+			trackerggscraper.RankPlaylist_TOURNAMENTS: "Tournois",
+		},
+	}
+	localizedRanksL = map[string]map[int]string{
+		"de": {
+			0: "Ungewertet", 1: "Bronze I", 2: "Bronze II", 3: "Bronze III",
+			4: "Silber I", 5: "Silber II", 6: "Silber III", 7: "Gold I", 8: "Gold II", 9: "Gold III",
+			10: "Platin I", 11: "Platin II", 12: "Platin III", 13: "Diamant I", 14: "Diamant II", 15: "Diamant III",
+			16: "Champion I", 17: "Champion II", 18: "Champion III", 19: "Grand Champion I", 20: "Grand Champion II",
+			21: "Grand Champion III", 22: "Supersonic Legend",
+		},
+		"es": {
+			0: "Sin rango", 1: "Bronce I", 2: "Bronce II", 3: "Bronce III",
+			4: "Plata I", 5: "Plata II", 6: "Plata III", 7: "Oro I", 8: "Oro II", 9: "Oro III",
+			10: "Platino I", 11: "Platino II", 12: "Platino III", 13: "Diamante I", 14: "Diamante II", 15: "Diamante III",
+			16: "Campeón I", 17: "Campeón II", 18: "Campeón III", 19: "Gran Campeón I", 20: "Gran Campeón II",
+			21: "Gran Campeón III", 22: "Leyenda Supersónica",
+		},
+		"fr": {
+			0: "Non classé", 1: "Bronze I", 2: "Bronze II", 3: "Bronze III",
+			4: "Argent I", 5: "Argent II", 6: "Argent III", 7: "Or I", 8: "Or II", 9: "Or III",
+			10: "Platine I", 11: "Platine II", 12: "Platine III", 13: "Diamant I", 14: "Diamant II", 15: "Diamant III",
+			16: "Champion I", 17: "Champion II", 18: "Champion III", 19: "Grand Champion I", 20: "Grand Champion II",
+			21: "Grand Champion III", 22: "Légende Supersonique",
+		},
+	}
+)
+
+// localizedRankName returns the name of a rank in the given language, or its English name if there is no translation
+func localizedRankName(names map[int]string, localized map[string]map[int]string, rank int, language string) string {
+	if name, ok := localized[language][rank]; ok {
+		return name
+	}
+	return names[rank]
+}
+
+// invocationLanguage returns the language of an invocation, which may be nil
+func invocationLanguage(invocation *Invocation) string {
+	if invocation == nil {
+		return ""
+	}
+	return invocation.Language
+}
@@ -0,0 +1,59 @@
+package formatter
+
+import "RocketRankBot/services/commander/rpc/trackerggscraper"
+
+var samplePlaylists = []struct {
+	playlist trackerggscraper.RankPlaylist
+	rank     int
+	division int
+	mmr      int
+	session  SessionStats
+}{
+	{trackerggscraper.RankPlaylist_UNRANKED, 0, 0, 1104, SessionStats{}},
+	{trackerggscraper.RankPlaylist_RANKED_1V1, 16, 1, 1052, SessionStats{MMRDelta: -9, Wins: 1, Losses: 2}},
+	{trackerggscraper.RankPlaylist_RANKED_2V2, 19, 0, 1478, SessionStats{MMRDelta: 27, Wins: 5, Losses: 3}},
+	{trackerggscraper.RankPlaylist_RANKED_3V3, 18, 3, 1341, SessionStats{}},
+	{trackerggscraper.RankPlaylist_HOOPS, 15, 2, 985, SessionStats{}},
+	{trackerggscraper.RankPlaylist_RUMBLE, 17, 0, 1118, SessionStats{}},
+	{trackerggscraper.RankPlaylist_DROPSHOT, 14, 1, 902, SessionStats{}},
+	{trackerggscraper.RankPlaylist_SNOWDAY, 13, 3, 871, SessionStats{}},
+	{trackerggscraper.RankPlaylist_TOURNAMENTS, 17, 0, 0, SessionStats{}},
+}
+
+// sampleInvocation has as many args as $(argN) can reference, so templates indexing .Args validate like $(argN) does
+var sampleInvocation = Invocation{
+	Args:    []string{"arg1", "arg2", "arg3", "arg4", "arg5", "arg6", "arg7", "arg8", "arg9"},
+	Sender:  "SampleSender",
+	Channel: "SampleChannel",
+}
+
+// SampleAccounts returns made up accounts that are ranked in every playlist and have session and peak data, so formats
+// can be rendered without fetching real ranks
+func SampleAccounts(count int) []AccountData {
+	accounts := make([]AccountData, max(count, 1))
+	for i := range accounts {
+		accounts[i] = AccountData{
+			Ranks:   &trackerggscraper.PlayerCurrentRanksRes{DisplayName: "SamplePlayer"},
+			Session: make(map[trackerggscraper.RankPlaylist]SessionStats),
+			Peaks:   make(map[trackerggscraper.RankPlaylist]PeakStats),
+		}
+		for _, sample := range samplePlaylists {
+			accounts[i].Ranks.Ranks = append(accounts[i].Ranks.Ranks, &trackerggscraper.PlayerRank{
+				Playlist: sample.playlist,
+				Mmr:      int32(sample.mmr),
+				Rank:     int32(sample.rank),
+				Division: int32(sample.division),
+			})
+			accounts[i].Session[sample.playlist] = sample.session
+			accounts[i].Peaks[sample.playlist] = PeakStats{
+				SeasonMMR:       sample.mmr + 35,
+				SeasonRank:      sample.rank,
+				SeasonDivision:  min(sample.division+1, 3),
+				AllTimeMMR:      sample.mmr + 120,
+				AllTimeRank:     min(sample.rank+1, 22),
+				AllTimeDivision: 0,
+			}
+		}
+	}
+	return accounts
+}
@@ -0,0 +1,391 @@
+package formatter
+
+import (
+	"RocketRankBot/services/commander/rpc/trackerggscraper"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
+	"text/template/parse"
+	"unicode/utf8"
+)
+
+const (
+	// maxTemplateOutputLength caps the output of a template well above what fits into a few chat messages
+	maxTemplateOutputLength = 2000
+	// maxTemplateRangeDepth limits nested range loops, which is enough to loop over the playlists of every account
+	maxTemplateRangeDepth = 2
+	// maxTemplateErrorLength keeps error messages short enough to fit into a chat message with the format
+	maxTemplateErrorLength = 80
+)
+
+var (
+	ErrTemplateOutputTooLong = errors.New("template output is too long")
+	ErrTemplateDefinitions   = errors.New("defining or calling templates is not allowed")
+	ErrTemplateRange         = errors.New("range is only allowed over .Accounts, .Playlists and .Args")
+	ErrTemplateRangeDepth    = errors.New("range can not be nested more than " + strconv.Itoa(maxTemplateRangeDepth) + " levels deep")
+	ErrTemplatePrintf        = errors.New("printf is not available, use the padding functions instead")
+	sandboxErrors            = []error{ErrTemplateOutputTooLong, ErrTemplateDefinitions, ErrTemplateRange, ErrTemplateRangeDepth, ErrTemplatePrintf}
+	// templateLocation matches the "name:line" location text/template puts in front of its errors
+	templateLocation = regexp.MustCompile(`format:(\d+)(?::\d+)?: `)
+	unknownField     = regexp.MustCompile(`can't evaluate field (\w+)`)
+	wrongArgs        = regexp.MustCompile(`wrong number of args for (\w+)`)
+	// rangeFields are the only fields that can be ranged over, all of them hold a small and bounded number of items
+	rangeFields = map[string]struct{}{
+		"Accounts":  {},
+		"Playlists": {},
+		"Args":      {},
+	}
+)
+
+// TemplateError is a short description of why a template can not be rendered. The errors of text/template name Go
+// types and internals, so they are never shown in chat directly.
+type TemplateError struct {
+	// Line is 0 if the error has no location
+	Line   int
+	Reason string
+	err    error
+}
+
+func (e *TemplateError) Error() string {
+	if e.Line > 0 {
+		return "line " + strconv.Itoa(e.Line) + ": " + e.Reason
+	}
+	return e.Reason
+}
+
+func (e *TemplateError) Unwrap() error {
+	return e.err
+}
+
+// newTemplateError maps an error of text/template or of the sandbox to a TemplateError
+func newTemplateError(err error) *TemplateError {
+	templateErr := TemplateError{err: err}
+
+	message := err.Error()
+	if location := templateLocation.FindStringSubmatchIndex(message); location != nil {
+		templateErr.Line, _ = strconv.Atoi(message[location[2]:location[3]])
+		message = message[location[1]:]
+	}
+
+	for _, sandboxErr := range sandboxErrors {
+		if errors.Is(err, sandboxErr) {
+			templateErr.Reason = sandboxErr.Error()
+			return &templateErr
+		}
+	}
+
+	var execErr template.ExecError
+	if match := unknownField.FindStringSubmatch(message); match != nil {
+		templateErr.Reason = "unknown field " + match[1]
+	} else if match = wrongArgs.FindStringSubmatch(message); match != nil {
+		templateErr.Reason = "wrong number of arguments for " + match[1]
+	} else if errors.As(err, &execErr) {
+		templateErr.Reason = "the template can not be rendered"
+	} else {
+		// Parse errors describe the syntax problem without internals, e.g. `function "foo" not defined`
+		templateErr.Reason = message
+		if utf8.RuneCountInString(message) > maxTemplateErrorLength {
+			templateErr.Reason = string([]rune(message)[:maxTemplateErrorLength]) + "..."
+		}
+	}
+	return &templateErr
+}
+
+// DivisionThresholds holds the lowest known MMR of every division by playlist
+type DivisionThresholds map[trackerggscraper.RankPlaylist]map[RankDivision]int
+
+// RankDivision identifies a division of a rank
+type RankDivision struct {
+	Rank     int
+	Division int
+}
+
+type templateData struct {
+	Accounts []*templateAccount
+	Args     []string
+	Sender   string
+	Channel  string
+}
+
+type templateAccount struct {
+	Name      string
+	Playlists []*templatePlaylist
+}
+
+type templatePlaylist struct {
+	// Key is the name of the playlist as typed in chat, e.g. "2v2"
+	Key      string
+	Name     string
+	Rank     int
+	Division int
+	MMR      int
+	Ranked   bool
+	// Session is nil if the channel is not live
+	Session *SessionStats
+	// Peak is nil if there is no peak data for the playlist
+	Peak     *PeakStats
+	playlist trackerggscraper.RankPlaylist
+}
+
+// Account returns the first account of the command
+func (d *templateData) Account() *templateAccount {
+	if len(d.Accounts) == 0 {
+		return nil
+	}
+	return d.Accounts[0]
+}
+
+// Arg returns the nth chat argument, or an empty string if it was not given
+func (d *templateData) Arg(n int) string {
+	if n < 1 || n > len(d.Args) {
+		return ""
+	}
+	return d.Args[n-1]
+}
+
+// Playlist returns the ranking in the playlist given by its chat name or token abbreviation, or nil if the account
+// has no ranking there
+func (a *templateAccount) Playlist(name string) *templatePlaylist {
+	playlist, ok := ParsePlaylist(name)
+	if !ok {
+		return nil
+	}
+	for _, p := range a.Playlists {
+		if p.playlist == playlist {
+			return p
+		}
+	}
+	return nil
+}
+
+// TemplateUsesSession reports whether a template refers to stream session data
+func TemplateUsesSession(templateString string) bool {
+	return strings.Contains(templateString, "Session")
+}
+
+// TemplateUsesPeaks reports whether a template refers to peak data
+func TemplateUsesPeaks(templateString string) bool {
+	return strings.Contains(templateString, "Peak")
+}
+
+// TemplateUsesDivisionThresholds reports whether a template needs division thresholds
+func TemplateUsesDivisionThresholds(templateString string) bool {
+	return strings.Contains(templateString, "toNextDiv")
+}
+
+// FormatTemplate renders a text/template format for the given accounts. Templates are restricted so they can not run
+// for long or produce large outputs: they can not define templates, can only range over small collections and their
+// output is capped. Errors are returned as *TemplateError.
+func FormatTemplate(accounts []AccountData, invocation *Invocation, thresholds DivisionThresholds, templateString string) (string, error) {
+	tmpl, err := parseTemplate(templateString, thresholds, invocation)
+	if err != nil {
+		return "", newTemplateError(err)
+	}
+
+	output := limitedWriter{}
+	err = tmpl.Execute(&output, newTemplateData(accounts, invocation))
+	if err != nil {
+		return "", newTemplateError(err)
+	}
+
+	return output.builder.String(), nil
+}
+
+func parseTemplate(templateString string, thresholds DivisionThresholds, invocation *Invocation) (*template.Template, error) {
+	tmpl, err := template.New("format").Funcs(templateFuncs(thresholds, invocation)).Parse(templateString)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(tmpl.Templates()) > 1 {
+		return nil, ErrTemplateDefinitions
+	}
+	err = validateTemplateNode(tmpl.Tree, tmpl.Tree.Root, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	return tmpl, nil
+}
+
+func validateTemplateNode(tree *parse.Tree, node parse.Node, rangeDepth int) error {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+		for _, child := range n.Nodes {
+			err := validateTemplateNode(tree, child, rangeDepth)
+			if err != nil {
+				return err
+			}
+		}
+	case *parse.TemplateNode:
+		return templateNodeError(tree, n, ErrTemplateDefinitions)
+	case *parse.IfNode:
+		return validateTemplateBranch(tree, &n.BranchNode, rangeDepth)
+	case *parse.WithNode:
+		return validateTemplateBranch(tree, &n.BranchNode, rangeDepth)
+	case *parse.RangeNode:
+		if rangeDepth >= maxTemplateRangeDepth {
+			return templateNodeError(tree, n, ErrTemplateRangeDepth)
+		}
+		if !isRangeFieldPipe(n.Pipe) {
+			return templateNodeError(tree, n, ErrTemplateRange)
+		}
+		return validateTemplateBranch(tree, &n.BranchNode, rangeDepth+1)
+	}
+	return nil
+}
+
+func validateTemplateBranch(tree *parse.Tree, branch *parse.BranchNode, rangeDepth int) error {
+	err := validateTemplateNode(tree, branch.List, rangeDepth)
+	if err != nil {
+		return err
+	}
+	return validateTemplateNode(tree, branch.ElseList, rangeDepth)
+}
+
+// templateNodeError prefixes err with the location of node, in the same "name:line:column" form text/template uses
+func templateNodeError(tree *parse.Tree, node parse.Node, err error) error {
+	location, _ := tree.ErrorContext(node)
+	return fmt.Errorf("%s: %w", location, err)
+}
+
+// isRangeFieldPipe reports whether a range pipe consists of nothing but one of the rangeFields, so it can not loop
+// over a number
+func isRangeFieldPipe(pipe *parse.PipeNode) bool {
+	if len(pipe.Cmds) != 1 || len(pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+
+	var ident []string
+	switch arg := pipe.Cmds[0].Args[0].(type) {
+	case *parse.FieldNode:
+		ident = arg.Ident
+	case *parse.VariableNode:
+		ident = arg.Ident[1:]
+	}
+	if len(ident) == 0 {
+		return false
+	}
+
+	_, ok := rangeFields[ident[len(ident)-1]]
+	return ok
+}
+
+func templateFuncs(thresholds DivisionThresholds, invocation *Invocation) template.FuncMap {
+	language := invocationLanguage(invocation)
+	return template.FuncMap{
+		"rankName": func(rank int, division int) string {
+			return RankName(rank, division, language)
+		},
+		"shortRank": ShortRankName,
+		"rank": func(rank int, modifier string) string {
+			return rankToStr(rank, modifier, language)
+		},
+		"division": divisionToStr,
+		"roman":    toRoman,
+		"signed":   signedIntToStr,
+		"rankEmote": func(rank int) string {
+			return rankNameOrEmote(invocation, rank, "e")
+		},
+		"padLeft": func(width int, s string) string {
+			return padding(width, s) + s
+		},
+		"padRight": func(width int, s string) string {
+			return s + padding(width, s)
+		},
+		"toNextDiv": func(p *templatePlaylist) string {
+			return mmrToNextDivision(thresholds, p)
+		},
+		// the builtin printf allows arbitrary widths and is replaced by the padding functions
+		"printf": func(string, ...any) (string, error) {
+			return "", ErrTemplatePrintf
+		},
+	}
+}
+
+func padding(width int, s string) string {
+	width = min(width, maxTemplateOutputLength) - utf8.RuneCountInString(s)
+	if width <= 0 {
+		return ""
+	}
+	return strings.Repeat(" ", width)
+}
+
+// mmrToNextDivision returns the MMR still missing to reach the next division, or "?" if that division has not been
+// observed yet
+func mmrToNextDivision(thresholds DivisionThresholds, p *templatePlaylist) string {
+	if p == nil {
+		return "?"
+	}
+
+	next := RankDivision{Rank: p.Rank, Division: p.Division + 1}
+	if next.Division > 3 {
+		next = RankDivision{Rank: p.Rank + 1, Division: 0}
+	}
+
+	threshold, ok := thresholds[p.playlist][next]
+	if !ok {
+		return "?"
+	}
+	return strconv.Itoa(max(threshold-p.MMR, 0))
+}
+
+func newTemplateData(accounts []AccountData, invocation *Invocation) *templateData {
+	data := templateData{}
+	if invocation != nil {
+		data.Args = invocation.Args
+		data.Sender = invocation.Sender
+		data.Channel = invocation.Channel
+	}
+
+	for _, account := range accounts {
+		templateAcc := templateAccount{Name: account.Ranks.DisplayName}
+		for _, ranking := range account.Ranks.Ranks {
+			p := templatePlaylist{
+				Key:      playlistKey(ranking.Playlist),
+				Name:     PlaylistName(ranking.Playlist, invocationLanguage(invocation)),
+				Rank:     int(ranking.Rank),
+				Division: int(ranking.Division),
+				MMR:      int(ranking.Mmr),
+				Ranked:   ranking.Rank > 0,
+				playlist: ranking.Playlist,
+			}
+			if session, ok := account.Session[ranking.Playlist]; ok {
+				p.Session = &session
+			}
+			if peak, ok := account.Peaks[ranking.Playlist]; ok {
+				p.Peak = &peak
+			}
+			templateAcc.Playlists = append(templateAcc.Playlists, &p)
+		}
+		data.Accounts = append(data.Accounts, &templateAcc)
+	}
+
+	return &data
+}
+
+func playlistKey(playlist trackerggscraper.RankPlaylist) string {
+	for key, p := range playlistArgs {
+		if p == playlist {
+			return key
+		}
+	}
+	return playlist.String()
+}
+
+type limitedWriter struct {
+	builder strings.Builder
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.builder.Len()+len(p) > maxTemplateOutputLength {
+		return 0, ErrTemplateOutputTooLong
+	}
+	return w.builder.Write(p)
+}
@@ -0,0 +1,183 @@
+package formatter
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"text/template/parse"
+)
+
+func TestFormatTemplateSandbox(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  error
+	}{
+		{name: "plain text", template: "hello"},
+		{name: "field access", template: "{{.Account.Name}} {{(.Account.Playlist \"2v2\").MMR}}"},
+		{name: "range over accounts", template: "{{range .Accounts}}{{.Name}}{{end}}"},
+		{name: "range over args", template: "{{range .Args}}{{.}}{{end}}"},
+		{name: "range over variable field", template: "{{$a := .Account}}{{range $a.Playlists}}{{.Key}}{{end}}"},
+		{name: "nested range within limit", template: "{{range .Accounts}}{{range .Playlists}}{{.MMR}}{{end}}{{end}}"},
+		{name: "nested range too deep", template: "{{range .Accounts}}{{range .Playlists}}{{range $.Args}}{{.}}{{end}}{{end}}{{end}}", wantErr: ErrTemplateRangeDepth},
+		{name: "range too deep inside if", template: "{{range .Accounts}}{{if true}}{{range .Playlists}}{{range $.Args}}x{{end}}{{end}}{{end}}{{end}}", wantErr: ErrTemplateRangeDepth},
+		{name: "range too deep inside else", template: "{{range .Accounts}}{{range .Playlists}}{{with .Peak}}{{else}}{{range $.Args}}x{{end}}{{end}}{{end}}{{end}}", wantErr: ErrTemplateRangeDepth},
+		{name: "range over integer", template: "{{range 1000000000}}x{{end}}", wantErr: ErrTemplateRange},
+		{name: "range over pipeline", template: "{{range .Account.Playlists | len}}x{{end}}", wantErr: ErrTemplateRange},
+		{name: "range over method result", template: "{{range .Account.Playlist \"2v2\"}}x{{end}}", wantErr: ErrTemplateRange},
+		{name: "range over other field", template: "{{range .Sender}}x{{end}}", wantErr: ErrTemplateRange},
+		{name: "define", template: "{{define \"loop\"}}x{{end}}", wantErr: ErrTemplateDefinitions},
+		{name: "template call", template: "{{template \"format\"}}", wantErr: ErrTemplateDefinitions},
+		{name: "block", template: "{{block \"b\" .}}x{{end}}", wantErr: ErrTemplateDefinitions},
+		{name: "printf", template: "{{printf \"%999999999d\" 1}}", wantErr: ErrTemplatePrintf},
+		{name: "printf in pipeline", template: "{{1 | printf \"%d\"}}", wantErr: ErrTemplatePrintf},
+		{name: "oversized text", template: strings.Repeat("x", maxTemplateOutputLength+1), wantErr: ErrTemplateOutputTooLong},
+		{name: "oversized range output", template: "{{range .Accounts}}{{range .Playlists}}" + strings.Repeat("x", 300) + "{{end}}{{end}}", wantErr: ErrTemplateOutputTooLong},
+		{name: "padding is capped", template: "{{padLeft 1000000000 \"x\"}}"},
+		{name: "capped padding with more text", template: "{{padLeft 1000000000 \"x\"}}y", wantErr: ErrTemplateOutputTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := FormatTemplate(SampleAccounts(1), &Invocation{Args: []string{"a", "b"}}, nil, tt.template)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("FormatTemplate() error = %v, want nil", err)
+				}
+				return
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("FormatTemplate() error = %v, want %v", err, tt.wantErr)
+			}
+
+			var templateErr *TemplateError
+			if !errors.As(err, &templateErr) {
+				t.Fatalf("FormatTemplate() error = %T, want *TemplateError", err)
+			}
+		})
+	}
+}
+
+func TestValidateTemplateNode(t *testing.T) {
+	tests := []struct {
+		name       string
+		template   string
+		rangeDepth int
+		wantErr    error
+	}{
+		{name: "no range", template: "{{.Sender}}"},
+		{name: "range at depth 0", template: "{{range .Args}}{{end}}"},
+		{name: "range at last allowed depth", template: "{{range .Args}}{{end}}", rangeDepth: maxTemplateRangeDepth - 1},
+		{name: "range at max depth", template: "{{range .Args}}{{end}}", rangeDepth: maxTemplateRangeDepth, wantErr: ErrTemplateRangeDepth},
+		{name: "nested range at max depth", template: "{{range .Args}}{{range .Args}}{{end}}{{end}}", rangeDepth: maxTemplateRangeDepth - 1, wantErr: ErrTemplateRangeDepth},
+		{name: "range over variable", template: "{{$x := 5}}{{range $x}}{{end}}", wantErr: ErrTemplateRange},
+		{name: "range with two arguments", template: "{{range .Args .Args}}{{end}}", wantErr: ErrTemplateRange},
+		{name: "template node", template: "{{template \"x\"}}", wantErr: ErrTemplateDefinitions},
+		{name: "template node in with", template: "{{with .Sender}}{{template \"x\"}}{{end}}", wantErr: ErrTemplateDefinitions},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trees, err := parse.Parse("format", tt.template, "", "", map[string]any{})
+			if err != nil {
+				t.Fatalf("parse.Parse() error = %v", err)
+			}
+			tree := trees["format"]
+
+			err = validateTemplateNode(tree, tree.Root, tt.rangeDepth)
+			if tt.wantErr == nil && err != nil {
+				t.Fatalf("validateTemplateNode() error = %v, want nil", err)
+			}
+			if tt.wantErr != nil && !errors.Is(err, tt.wantErr) {
+				t.Fatalf("validateTemplateNode() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestLimitedWriter(t *testing.T) {
+	tests := []struct {
+		name    string
+		writes  []int
+		wantLen int
+		wantErr bool
+	}{
+		{name: "empty write", writes: []int{0}, wantLen: 0},
+		{name: "exactly at limit", writes: []int{maxTemplateOutputLength}, wantLen: maxTemplateOutputLength},
+		{name: "over limit in one write", writes: []int{maxTemplateOutputLength + 1}, wantLen: 0, wantErr: true},
+		{name: "over limit across writes", writes: []int{maxTemplateOutputLength - 1, 2}, wantLen: maxTemplateOutputLength - 1, wantErr: true},
+		{name: "many small writes", writes: []int{500, 500, 500, 500}, wantLen: maxTemplateOutputLength},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := limitedWriter{}
+			var err error
+			for _, n := range tt.writes {
+				_, err = w.Write([]byte(strings.Repeat("x", n)))
+				if err != nil {
+					break
+				}
+			}
+
+			if tt.wantErr != errors.Is(err, ErrTemplateOutputTooLong) {
+				t.Fatalf("Write() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if w.builder.Len() != tt.wantLen {
+				t.Fatalf("written length = %d, want %d", w.builder.Len(), tt.wantLen)
+			}
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		wantErr  bool
+	}{
+		{name: "first arg", template: "{{index .Args 0}}"},
+		{name: "last arg", template: "{{index .Args 8}}"},
+		{name: "arg beyond argN", template: "{{index .Args 9}}", wantErr: true},
+		{name: "sender and channel", template: "{{.Sender}} {{.Channel}}"},
+		{name: "unknown field", template: "{{.Foo}}", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTemplate(tt.template, 1)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("ValidateTemplate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestTemplateErrorHidesInternals(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		want     string
+	}{
+		{name: "unknown field", template: "{{.Foo}}", want: "line 1: unknown field Foo"},
+		{name: "unknown function", template: "hi\n{{foo}}", want: "line 2: function \"foo\" not defined"},
+		{name: "wrong arguments", template: "{{padLeft 1}}", want: "line 1: wrong number of arguments for padLeft"},
+		{name: "sandbox error", template: "{{range 3}}{{end}}", want: "line 1: " + ErrTemplateRange.Error()},
+		{name: "output too long", template: strings.Repeat("x", maxTemplateOutputLength+1), want: ErrTemplateOutputTooLong.Error()},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateTemplate(tt.template, 1)
+			if err == nil {
+				t.Fatal("ValidateTemplate() error = nil")
+			}
+			if err.Error() != tt.want {
+				t.Fatalf("ValidateTemplate() error = %q, want %q", err.Error(), tt.want)
+			}
+			if strings.Contains(err.Error(), "formatter.") || strings.Contains(err.Error(), "template:") {
+				t.Fatalf("ValidateTemplate() error %q leaks text/template internals", err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package formatter
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxErrorTokenLength = 20
+
+// FormatError describes the first invalid token of a format
+type FormatError struct {
+	Token string
+	// Position is the 1-based position of the token in the format, counted in characters
+	Position int
+	Reason   string
+}
+
+func (e *FormatError) Error() string {
+	return e.Token + " at position " + strconv.Itoa(e.Position) + ": " + e.Reason
+}
+
+// ValidateFormat checks that every token of a $(...) format can be evaluated for a command with the given number of
+// accounts. It returns a *FormatError for the first token that can not.
+func ValidateFormat(formatString string, accountCount int) error {
+	tokenStarts := make(map[int]struct{})
+	for _, match := range tokenMatcher.FindAllStringSubmatchIndex(formatString, -1) {
+		tokenStarts[match[0]] = struct{}{}
+
+		token := formatString[match[2]:match[3]]
+		reason := validateToken(token, accountCount)
+		if len(reason) > 0 {
+			return &FormatError{
+				Token:    formatString[match[0]:match[1]],
+				Position: utf8.RuneCountInString(formatString[:match[0]]) + 1,
+				Reason:   reason,
+			}
+		}
+	}
+
+	// "$(" that does not start a token is most likely a typo, like a missing closing parenthesis
+	offset := 0
+	for {
+		index := strings.Index(formatString[offset:], "$(")
+		if index < 0 {
+			return nil
+		}
+		index += offset
+		if _, ok := tokenStarts[index]; !ok {
+			return &FormatError{
+				Token:    truncateErrorToken(formatString[index:]),
+				Position: utf8.RuneCountInString(formatString[:index]) + 1,
+				Reason:   "token is not closed or contains invalid characters",
+			}
+		}
+		offset = index + 2
+	}
+}
+
+// ValidateTemplate checks that a text/template format parses, stays within the sandbox and can be rendered for a
+// command with the given number of accounts. It returns a *TemplateError if it can not.
+func ValidateTemplate(templateString string, accountCount int) error {
+	tmpl, err := parseTemplate(templateString, nil, nil)
+	if err != nil {
+		return newTemplateError(err)
+	}
+
+	// Unknown fields and wrong function arguments are only detected when the template is executed
+	output := limitedWriter{}
+	invocation := sampleInvocation
+	err = tmpl.Execute(&output, newTemplateData(SampleAccounts(accountCount), &invocation))
+	if err != nil {
+		return newTemplateError(err)
+	}
+	return nil
+}
+
+// validateToken returns why a token can not be evaluated, or an empty string if it can
+func validateToken(token string, accountCount int) string {
+	switch token {
+	case "args", "sender", "channel":
+		return ""
+	}
+	if argExtractor.MatchString(token) {
+		return ""
+	}
+
+	selector := "acc"
+	rankToken := token
+
+	accountMatches := accountExtractor.FindStringSubmatch(token)
+	if accountMatches != nil {
+		if accountMatches[2] != "" {
+			accountIndex, _ := strconv.Atoi(accountMatches[2])
+			if accountIndex > accountCount {
+				return "the command only has " + strconv.Itoa(accountCount) + " account(s)"
+			}
+		} else {
+			selector = accountMatches[1]
+		}
+		rankToken = accountMatches[3]
+	}
+
+	if rankToken == "name" {
+		if selector != "acc" {
+			return "name can not be combined with " + selector
+		}
+		return ""
+	}
+
+	if !tokenExtractor.MatchString(rankToken) {
+		return "unknown token"
+	}
+	return ""
+}
+
+func truncateErrorToken(token string) string {
+	if end := strings.Index(token, " "); end > 0 {
+		token = token[:end]
+	}
+	if utf8.RuneCountInString(token) > maxErrorTokenLength {
+		token = string([]rune(token)[:maxErrorTokenLength]) + "..."
+	}
+	return token
+}
@@ -0,0 +1,51 @@
+package formatter
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateFormat(t *testing.T) {
+	tests := []struct {
+		name         string
+		format       string
+		accountCount int
+		wantErr      *FormatError
+	}{
+		{name: "plain text", format: "hello", accountCount: 1},
+		{name: "valid tokens", format: "$(sender): $(2.r) $(acc1.name) $(arg1)", accountCount: 1},
+		{name: "second account within count", format: "$(acc2.2.r)", accountCount: 2},
+		{name: "selector with rank token", format: "$(maxrank.2.r) $(maxmmr.h.m)", accountCount: 1},
+		{name: "unknown token", format: "$(foo)", accountCount: 1, wantErr: &FormatError{Token: "$(foo)", Position: 1, Reason: "unknown token"}},
+		{name: "position after multibyte text", format: "größe $(foo)", accountCount: 1, wantErr: &FormatError{Token: "$(foo)", Position: 7, Reason: "unknown token"}},
+		{name: "position after emoji", format: "🔥🔥 $(2.x)", accountCount: 1, wantErr: &FormatError{Token: "$(2.x)", Position: 4, Reason: "unknown token"}},
+		{name: "account beyond count", format: "$(2.r) $(acc2.2.r)", accountCount: 1, wantErr: &FormatError{Token: "$(acc2.2.r)", Position: 8, Reason: "the command only has 1 account(s)"}},
+		{name: "maxrank name", format: "$(maxrank.name)", accountCount: 2, wantErr: &FormatError{Token: "$(maxrank.name)", Position: 1, Reason: "name can not be combined with maxrank"}},
+		{name: "maxmmr name", format: "$(maxmmr.name)", accountCount: 2, wantErr: &FormatError{Token: "$(maxmmr.name)", Position: 1, Reason: "name can not be combined with maxmmr"}},
+		{name: "unclosed token", format: "$(2.r", accountCount: 1, wantErr: &FormatError{Token: "$(2.r", Position: 1, Reason: "token is not closed or contains invalid characters"}},
+		{name: "unclosed token after multibyte text", format: "ä $(2.r) ö $(3.r", accountCount: 1, wantErr: &FormatError{Token: "$(3.r", Position: 12, Reason: "token is not closed or contains invalid characters"}},
+		{name: "unclosed token is cut at a space", format: "$(2.r and more", accountCount: 1, wantErr: &FormatError{Token: "$(2.r", Position: 1, Reason: "token is not closed or contains invalid characters"}},
+		{name: "long unclosed token is truncated", format: "$(aaaaaaaaaaaaaaaaaaaaaaaa", accountCount: 1, wantErr: &FormatError{Token: "$(aaaaaaaaaaaaaaaaaa...", Position: 1, Reason: "token is not closed or contains invalid characters"}},
+		{name: "invalid characters", format: "$(2-r)", accountCount: 1, wantErr: &FormatError{Token: "$(2-r)", Position: 1, Reason: "token is not closed or contains invalid characters"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateFormat(tt.format, tt.accountCount)
+			if tt.wantErr == nil {
+				if err != nil {
+					t.Fatalf("ValidateFormat() error = %v, want nil", err)
+				}
+				return
+			}
+
+			var formatErr *FormatError
+			if !errors.As(err, &formatErr) {
+				t.Fatalf("ValidateFormat() error = %v, want *FormatError", err)
+			}
+			if *formatErr != *tt.wantErr {
+				t.Fatalf("ValidateFormat() error = %+v, want %+v", *formatErr, *tt.wantErr)
+			}
+		})
+	}
+}
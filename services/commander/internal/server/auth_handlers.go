@@ -2,7 +2,6 @@ package server
 
 import (
 	"RocketRankBot/services/commander/internal/db"
-	"RocketRankBot/services/commander/internal/twitch"
 	"RocketRankBot/services/commander/internal/util"
 	"context"
 	"fmt"
@@ -16,7 +15,7 @@ import (
 const authStateCookieName = "twitch_oauth_state"
 
 var userScopes = []string{"channel:bot"}
-var botScopes = []string{"user:bot", "user:write:chat", "user:read:chat"}
+var botScopes = []string{"user:bot", "user:write:chat", "user:read:chat", "user:manage:whispers"}
 
 func (s *server) handleAuth(w http.ResponseWriter, r *http.Request) {
 	state := util.RandomAlphanumericalString(32)
@@ -90,57 +89,63 @@ func (s *server) handleAuthCallback(w http.ResponseWriter, r *http.Request) {
 				return
 			}
 		}
+	} else {
+		// Without all bot scopes, whispers and chat would only fail later, when the token is used
+		for _, s := range botScopes {
+			if !slices.Contains(tokenResponse.Scope, s) {
+				w.WriteHeader(http.StatusBadRequest)
+				_, _ = io.WriteString(w, "Authorization is missing required scope: "+s)
+				return
+			}
+		}
+
+		err = s.twitch.SetBotUserToken(r.Context(), tokenResponse)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = io.WriteString(w, fmt.Sprint("Error saving bot user token. Please try again later. trace-id: ", r.Context().Value("trace-id")))
+			log.Ctx(r.Context()).Error().Err(err).Msg("Could not store bot user token")
+			return
+		}
 	}
 
 	ctx := context.WithoutCancel(r.Context())
 
-	_, userExists, err := s.db.FindUser(ctx, user.Data[0].ID)
+	dbUser, userExists, err := s.db.FindUser(ctx, user.Data[0].ID)
 	if err != nil {
 		_, _ = io.WriteString(w, fmt.Sprint("Error saving user data. Please try again later. trace-id: ", ctx.Value("trace-id")))
 		log.Ctx(ctx).Error().Err(err).Msg("Error adding EventSub subscription to database")
 		return
 	}
 
+	var existingSubs []db.EventSubSubscription
 	if userExists {
-		// Delete old Subscriptions
 		oldSubs, err := s.db.FindEventSubSubscriptionsForTwitchUserID(ctx, user.Data[0].ID)
-		if err == nil {
-			for _, oldSub := range *oldSubs {
-				_ = s.twitch.DeleteEventSubSubscription(ctx, oldSub.SubscriptionID)
-			}
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = io.WriteString(w, fmt.Sprint("Internal server error. Please try again later. trace-id: ", ctx.Value("trace-id")))
+			log.Ctx(ctx).Error().Err(err).Msg("Error querying EventSub subscriptions")
+			return
+		}
+		if oldSubs != nil {
+			existingSubs = *oldSubs
 		}
-	}
-
-	transport, err := s.twitch.EventSubTransport(ctx)
-	if err != nil {
-		w.WriteHeader(http.StatusInternalServerError)
-		_, _ = io.WriteString(w, fmt.Sprint("Internal server error. Please try again later. trace-id: ", ctx.Value("trace-id")))
-		return
-	}
 
-	subReq := twitch.CreateEventSubSubscriptionRequest{
-		Type:      twitch.EventSubTypeChatMessage,
-		Version:   twitch.EventSubVersionChatMessage,
-		Condition: s.twitch.BotUserCondition(user.Data[0].ID),
-		Transport: *transport,
+		// Subscriptions of users whose authorization was revoked are no longer active at Twitch and are recreated
+		if !dbUser.IsAuthenticated {
+			subscriptionIDs := make([]string, 0, len(existingSubs))
+			for _, oldSub := range existingSubs {
+				subscriptionIDs = append(subscriptionIDs, oldSub.SubscriptionID)
+			}
+			s.removeChannelSubscriptions(ctx, subscriptionIDs)
+			existingSubs = nil
+		}
 	}
 
-	subscriptionID, err := s.twitch.CreateEventSubSubscription(ctx, subReq)
+	err = s.ensureChannelSubscriptions(ctx, user.Data[0].ID, existingSubs)
 	if err != nil {
 		w.WriteHeader(http.StatusInternalServerError)
 		_, _ = io.WriteString(w, fmt.Sprint("Error creating Twitch EventSub subscription. Please try again later. trace-id: ", ctx.Value("trace-id")))
-		return
-	}
-
-	dbSub := db.EventSubSubscription{
-		SubscriptionID: *subscriptionID,
-		TwitchUserID:   user.Data[0].ID,
-		Topic:          twitch.EventSubTypeChatMessage,
-	}
-	err = s.db.AddEventSubSubscription(ctx, &dbSub)
-	if err != nil {
-		_, _ = io.WriteString(w, fmt.Sprint("Error saving EventSub subscription. Please try again later. trace-id: ", ctx.Value("trace-id")))
-		log.Ctx(ctx).Error().Err(err).Msg("Error adding EventSub subscription to database")
+		log.Ctx(ctx).Error().Err(err).Msg("Error creating EventSub subscriptions")
 		return
 	}
 
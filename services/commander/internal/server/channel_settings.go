@@ -0,0 +1,95 @@
+package server
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"context"
+	"github.com/rs/zerolog/log"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// getChannelSettings returns the settings used to recognize and reply to commands in a channel. As they are needed for
+// every chat message, they are read from the cache and only loaded from the main DB if missing. If they can not be
+// loaded, the defaults are returned so commands keep working with the default prefix.
+func (s *server) getChannelSettings(ctx context.Context, channelID string) *db.CachedChannelSettings {
+	settings, found, err := s.cache.FindCachedChannelSettings(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error looking up cached channel settings")
+	}
+	if found {
+		return settings
+	}
+
+	settings = &db.CachedChannelSettings{}
+
+	dbUser, found, err := s.db.FindUser(ctx, channelID)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error looking up user in DB")
+		return settings
+	}
+	if found {
+		settings.CommandPrefix = dbUser.CommandPrefix
+		settings.Language = dbUser.Language
+		settings.KeywordTriggers, err = s.db.FindKeywordTriggers(ctx, channelID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Msg("Error looking up keyword triggers in DB")
+			return settings
+		}
+	}
+
+	err = s.cache.SetCachedChannelSettings(ctx, channelID, settings, s.channelSettingsTTL)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error updating channel settings cache")
+	}
+
+	return settings
+}
+
+// matchKeywordTrigger returns the command triggered by a chat message. A keyword triggers its command if the message
+// contains it as a whole word, ignoring case, so "rank" does not trigger on "frankly" or "ranked". If several keywords
+// match, the longest one wins, so more specific keywords take precedence.
+func matchKeywordTrigger(triggers map[string]string, message string) (string, bool) {
+	message = strings.ToLower(message)
+
+	var matchedKeyword string
+	for keyword := range triggers {
+		if len(keyword) < len(matchedKeyword) || (len(keyword) == len(matchedKeyword) && keyword > matchedKeyword) {
+			continue
+		}
+		if containsWord(message, keyword) {
+			matchedKeyword = keyword
+		}
+	}
+
+	if len(matchedKeyword) == 0 {
+		return "", false
+	}
+	return triggers[matchedKeyword], true
+}
+
+// containsWord reports whether word occurs in text without letters or digits directly before or after it
+func containsWord(text string, word string) bool {
+	offset := 0
+	for {
+		index := strings.Index(text[offset:], word)
+		if index < 0 {
+			return false
+		}
+		start := offset + index
+		end := start + len(word)
+
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+
+		_, size := utf8.DecodeRuneInString(text[start:])
+		offset = start + size
+	}
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_'
+}
@@ -0,0 +1,50 @@
+package server
+
+import "testing"
+
+func TestMatchKeywordTrigger(t *testing.T) {
+	triggers := map[string]string{
+		"rank":        "rank",
+		"what rank":   "myrank",
+		"gc":          "gc",
+		"größe":       "size",
+		"!elo":        "elo",
+		"rank please": "rankplease",
+	}
+
+	tests := []struct {
+		name      string
+		message   string
+		want      string
+		wantMatch bool
+	}{
+		{name: "exact message", message: "rank", want: "rank", wantMatch: true},
+		{name: "word in sentence", message: "what's your rank?", want: "rank", wantMatch: true},
+		{name: "ignores case", message: "RANK pls", want: "rank", wantMatch: true},
+		{name: "prefix of word", message: "ranked is hard", wantMatch: false},
+		{name: "suffix of word", message: "that was cranky", wantMatch: false},
+		{name: "inside of word", message: "frankly no", wantMatch: false},
+		{name: "digit after keyword", message: "rank1", wantMatch: false},
+		{name: "underscore before keyword", message: "my_rank", wantMatch: false},
+		{name: "later occurrence is a word", message: "frankly, what rank", want: "myrank", wantMatch: true},
+		{name: "second occurrence is a word", message: "ranked rank", want: "rank", wantMatch: true},
+		{name: "punctuation around keyword", message: "(gc)", want: "gc", wantMatch: true},
+		{name: "short keyword inside word", message: "gcs are rare", wantMatch: false},
+		{name: "longest keyword wins", message: "rank please", want: "rankplease", wantMatch: true},
+		{name: "multi word keyword", message: "hey what rank are you", want: "myrank", wantMatch: true},
+		{name: "multi word keyword across word", message: "somewhat rank", want: "rank", wantMatch: true},
+		{name: "non ascii keyword", message: "welche größe?", want: "size", wantMatch: true},
+		{name: "non ascii letter after keyword", message: "größer", wantMatch: false},
+		{name: "keyword starting with punctuation", message: "!elo now", want: "elo", wantMatch: true},
+		{name: "no keyword", message: "hello chat", wantMatch: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := matchKeywordTrigger(triggers, tt.message)
+			if ok != tt.wantMatch || got != tt.want {
+				t.Fatalf("matchKeywordTrigger(%q) = %q, %v, want %q, %v", tt.message, got, ok, tt.want, tt.wantMatch)
+			}
+		})
+	}
+}
@@ -0,0 +1,169 @@
+package server
+
+import (
+	"RocketRankBot/services/commander/internal/bot"
+	"RocketRankBot/services/commander/internal/db"
+	"RocketRankBot/services/commander/internal/util"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog/log"
+)
+
+const (
+	maxImportBodyBytes       = 1 << 20
+	maxAuthAttemptsPerMinute = 10
+)
+
+// authenticateChannel checks the API key or Twitch user access token of a request and returns the channel the request
+// may manage. API keys are bound to their channel. Broadcasters using a Twitch token manage their own channel, admins can
+// manage any channel through the channel_id query parameter.
+func (s *server) authenticateChannel(w http.ResponseWriter, r *http.Request) (string, db.CommandAuthor, bool) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || len(token) == 0 {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = io.WriteString(w, "Missing API key or Twitch user access token.")
+		return "", db.CommandAuthor{}, false
+	}
+
+	// Every attempt is counted, so neither API keys nor tokens can be guessed and Twitch is not called on every request
+	if !s.acquireAuthRateLimit(w, r) {
+		return "", db.CommandAuthor{}, false
+	}
+
+	if util.IsAPIKey(token) {
+		return s.authenticateAPIKey(w, r, token)
+	}
+
+	user, err := s.twitch.GetOwnUser(r.Context(), token)
+	if err != nil {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = io.WriteString(w, "Invalid Twitch user access token.")
+		return "", db.CommandAuthor{}, false
+	}
+
+	author := db.CommandAuthor{TwitchUserID: user.Data[0].ID, TwitchUserLogin: user.Data[0].Login}
+	channelID := author.TwitchUserID
+
+	if r.URL.Query().Has("channel_id") && r.URL.Query().Get("channel_id") != channelID {
+		if !slices.Contains(s.adminsUserIDs, author.TwitchUserID) {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = io.WriteString(w, "Only admins can manage other channels.")
+			return "", db.CommandAuthor{}, false
+		}
+		channelID = r.URL.Query().Get("channel_id")
+	}
+
+	return channelID, author, true
+}
+
+// authenticateAPIKey resolves an API key created with !apikey. Changes made with the key are attributed to the chatter
+// that created it.
+func (s *server) authenticateAPIKey(w http.ResponseWriter, r *http.Request, key string) (string, db.CommandAuthor, bool) {
+	apiKey, found, err := s.db.FindAPIKey(r.Context(), util.HashAPIKey(key))
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, fmt.Sprint("Error checking API key. Please try again later. trace-id: ", r.Context().Value("trace-id")))
+		log.Ctx(r.Context()).Error().Err(err).Msg("Could not query db for api key")
+		return "", db.CommandAuthor{}, false
+	}
+	if !found {
+		w.WriteHeader(http.StatusUnauthorized)
+		_, _ = io.WriteString(w, "Invalid API key.")
+		return "", db.CommandAuthor{}, false
+	}
+
+	if r.URL.Query().Has("channel_id") && r.URL.Query().Get("channel_id") != apiKey.TwitchUserID {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = io.WriteString(w, "API keys can only manage their own channel.")
+		return "", db.CommandAuthor{}, false
+	}
+
+	return apiKey.TwitchUserID, apiKey.CreatedBy, true
+}
+
+func (s *server) acquireAuthRateLimit(w http.ResponseWriter, r *http.Request) bool {
+	clientIP, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		clientIP = r.RemoteAddr
+	}
+
+	allowed, err := s.cache.AcquireRateLimit(r.Context(), "apiauth:"+clientIP, maxAuthAttemptsPerMinute, time.Minute)
+	if err != nil {
+		// Failing open keeps the API usable while the cache is unavailable
+		log.Ctx(r.Context()).Error().Err(err).Msg("Error acquiring api auth rate limit")
+		return true
+	}
+	if !allowed {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		_, _ = io.WriteString(w, "Too many requests. Please try again in a minute.")
+		return false
+	}
+
+	return true
+}
+
+func (s *server) handleCommandsExport(w http.ResponseWriter, r *http.Request) {
+	channelID, _, ok := s.authenticateChannel(w, r)
+	if !ok {
+		return
+	}
+
+	export, err := s.bot.ExportCommands(r.Context(), channelID)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		_, _ = io.WriteString(w, fmt.Sprint("Error exporting commands. Please try again later. trace-id: ", r.Context().Value("trace-id")))
+		log.Ctx(r.Context()).Error().Err(err).Msg("Could not export commands")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", "attachment; filename=\"commands-"+channelID+".json\"")
+	_ = json.NewEncoder(w).Encode(export)
+}
+
+func (s *server) handleCommandsImport(w http.ResponseWriter, r *http.Request) {
+	channelID, author, ok := s.authenticateChannel(w, r)
+	if !ok {
+		return
+	}
+
+	export := bot.CommandExport{}
+	err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxImportBodyBytes)).Decode(&export)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = io.WriteString(w, "Invalid command export: "+err.Error())
+		return
+	}
+
+	imported, err := s.bot.ImportCommands(r.Context(), channelID, &export, author)
+	if err != nil {
+		var importErr *bot.CommandImportError
+		switch {
+		case errors.As(err, &importErr):
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = io.WriteString(w, "Invalid command export: "+importErr.Error())
+		case errors.Is(err, bot.ErrChannelNotJoined):
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, "The bot has not joined this channel yet.")
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			_, _ = io.WriteString(w, fmt.Sprint("Error importing commands. Please try again later. trace-id: ", r.Context().Value("trace-id")))
+			log.Ctx(r.Context()).Error().Err(err).Msg("Could not import commands")
+		}
+		return
+	}
+
+	log.Ctx(r.Context()).Info().Str("channel_id", channelID).Str("user_id", author.TwitchUserID).Int("commands", imported).Msg("Imported commands")
+	w.WriteHeader(http.StatusOK)
+	_, _ = io.WriteString(w, "Imported "+strconv.Itoa(imported)+" commands.")
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"RocketRankBot/services/commander/internal/db"
+	"RocketRankBot/services/commander/internal/twitch"
+	"context"
+
+	"github.com/rs/zerolog/log"
+)
+
+// channelSubscriptionRequests returns the EventSub subscriptions every joined channel needs
+func (s *server) channelSubscriptionRequests(twitchUserID string, transport twitch.EventSubTransportReq) []twitch.CreateEventSubSubscriptionRequest {
+	return []twitch.CreateEventSubSubscriptionRequest{
+		{
+			Type:      twitch.EventSubTypeChatMessage,
+			Version:   twitch.EventSubVersionChatMessage,
+			Condition: s.twitch.BotUserCondition(twitchUserID),
+			Transport: transport,
+		},
+		{
+			Type:      twitch.EventSubTypeStreamOnline,
+			Version:   twitch.EventSubVersionStreamOnline,
+			Condition: twitch.BroadcasterCondition{BroadcasterUserId: twitchUserID},
+			Transport: transport,
+		},
+		{
+			Type:      twitch.EventSubTypeStreamOffline,
+			Version:   twitch.EventSubVersionStreamOffline,
+			Condition: twitch.BroadcasterCondition{BroadcasterUserId: twitchUserID},
+			Transport: transport,
+		},
+	}
+}
+
+// ensureChannelSubscriptions creates the subscriptions of a channel whose types do not exist yet. If one of them can not
+// be created, the ones created before are removed again, so a retry starts from the same state.
+func (s *server) ensureChannelSubscriptions(ctx context.Context, twitchUserID string, existing []db.EventSubSubscription) error {
+	transport, err := s.twitch.EventSubTransport(ctx)
+	if err != nil {
+		return err
+	}
+
+	existingTypes := make(map[string]struct{}, len(existing))
+	for _, sub := range existing {
+		existingTypes[sub.Topic] = struct{}{}
+	}
+
+	var created []string
+	for _, subReq := range s.channelSubscriptionRequests(twitchUserID, *transport) {
+		if _, ok := existingTypes[subReq.Type]; ok {
+			continue
+		}
+
+		subscriptionID, err := s.twitch.CreateEventSubSubscription(ctx, subReq)
+		if err == nil {
+			err = s.db.AddEventSubSubscription(ctx, &db.EventSubSubscription{
+				SubscriptionID: *subscriptionID,
+				TwitchUserID:   twitchUserID,
+				Topic:          subReq.Type,
+			})
+			if err != nil {
+				_ = s.twitch.DeleteEventSubSubscription(ctx, *subscriptionID)
+			}
+		}
+		if err != nil {
+			s.removeChannelSubscriptions(ctx, created)
+			return err
+		}
+		created = append(created, *subscriptionID)
+	}
+
+	return nil
+}
+
+// removeChannelSubscriptions deletes subscriptions both at Twitch and in the database
+func (s *server) removeChannelSubscriptions(ctx context.Context, subscriptionIDs []string) {
+	for _, subscriptionID := range subscriptionIDs {
+		err := s.twitch.DeleteEventSubSubscription(ctx, subscriptionID)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("subscription_id", subscriptionID).Msg("Could not delete EventSub subscription")
+		}
+		err = s.db.DeleteEventSubSubscription(ctx, subscriptionID)
+		if err != nil {
+			log.Ctx(ctx).Warn().Err(err).Str("subscription_id", subscriptionID).Msg("Could not delete EventSub subscription from database")
+		}
+	}
+}
+
+// backfillChannelSubscriptions creates missing subscriptions for channels that joined before a subscription type was
+// added, so they do not have to authorize again
+func (s *server) backfillChannelSubscriptions(ctx context.Context) {
+	userIDs, err := s.db.FindAuthenticatedUserIDs(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not query db for users to backfill EventSub subscriptions")
+		return
+	}
+
+	for _, userID := range userIDs {
+		subs, err := s.db.FindEventSubSubscriptionsForTwitchUserID(ctx, userID)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("user_id", userID).Msg("Could not query db for EventSub subscriptions")
+			continue
+		}
+
+		var existing []db.EventSubSubscription
+		if subs != nil {
+			existing = *subs
+		}
+		err = s.ensureChannelSubscriptions(ctx, userID, existing)
+		if err != nil {
+			log.Ctx(ctx).Error().Err(err).Str("user_id", userID).Msg("Could not backfill EventSub subscriptions")
+		}
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/rs/zerolog/log"
 	"net/http"
 	"strconv"
+	"time"
 )
 
 type Server interface {
@@ -24,7 +25,9 @@ type server struct {
 	cache               db.CacheDB
 	bot                 bot.Bot
 	commandPrefix       string
+	channelSettingsTTL  time.Duration
 	botTwitchUserName   string
+	botTwitchUserID     string
 	adminsUserIDs       []string
 }
 
@@ -38,7 +41,9 @@ func NewServer(cfg *config.CommanderConfig, twitchAPI twitch.API, mainDB db.Main
 		cache:               cacheDB,
 		bot:                 bot,
 		commandPrefix:       cfg.CommandPrefix,
+		channelSettingsTTL:  time.Second * time.Duration(cfg.TTL.ChannelSettings),
 		botTwitchUserName:   cfg.Twitch.BotUserName,
+		botTwitchUserID:     cfg.Twitch.BotUserID,
 		adminsUserIDs:       cfg.AdminUserIDs,
 	}
 }
@@ -49,6 +54,8 @@ func (s *server) Start(ctx context.Context) error {
 	mux.HandleFunc("/authbot", s.handleAuthBot)
 	mux.HandleFunc("/callback", s.handleAuthCallback)
 	mux.HandleFunc("/webhooks/twitch", s.handleTwitchWebHook)
+	mux.HandleFunc("GET /commands/export", s.handleCommandsExport)
+	mux.HandleFunc("POST /commands/import", s.handleCommandsImport)
 
 	log.Ctx(ctx).Info().Str("bind_address", s.bindAddress).Msg("Starting HTTP server")
 
@@ -64,5 +71,7 @@ func (s *server) Start(ctx context.Context) error {
 		return err
 	}
 
+	go s.backfillChannelSubscriptions(ctx)
+
 	return nil
 }
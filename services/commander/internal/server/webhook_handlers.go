@@ -157,14 +157,24 @@ func (s *server) handleWebHookNotification(w http.ResponseWriter, r *http.Reques
 	subscriptionType := r.Header.Get(headerEventSubSubscriptionType)
 	messageID := r.Header.Get(headerEventSubMessageID)
 
-	if subscriptionType != twitch.EventSubTypeChatMessage {
-		// ignore non-chat subscriptions for now
+	// Twitch retries notifications it considers undelivered, so every message is only handled once, even if several
+	// instances receive it
+	claimed, err := s.cache.ClaimCachedEventSubMsg(r.Context(), messageID)
+	if err != nil {
+		// Failing open keeps the bot responsive while the cache is unavailable
+		log.Ctx(r.Context()).Error().Err(err).Msg("Error claiming eventsub message")
+	} else if !claimed {
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
 
-	alreadyHandled, err := s.cache.HasCachedEventSubMsg(r.Context(), messageID)
-	if err != nil && alreadyHandled {
+	if subscriptionType == twitch.EventSubTypeStreamOnline || subscriptionType == twitch.EventSubTypeStreamOffline {
+		s.handleWebHookStreamNotification(w, r, subscriptionType, messageID, bodyData)
+		return
+	}
+
+	if subscriptionType != twitch.EventSubTypeChatMessage {
+		// ignore other subscriptions
 		w.WriteHeader(http.StatusNoContent)
 		return
 	}
@@ -174,6 +184,7 @@ func (s *server) handleWebHookNotification(w http.ResponseWriter, r *http.Reques
 	err = json.Unmarshal(bodyData, &notificationChat)
 	if err != nil {
 		log.Ctx(r.Context()).Warn().Err(err).Msg("could not parse webhook chat notification")
+		_ = s.cache.ReleaseCachedEventSubMsg(r.Context(), messageID)
 		w.WriteHeader(http.StatusInternalServerError)
 		return
 	}
@@ -181,6 +192,11 @@ func (s *server) handleWebHookNotification(w http.ResponseWriter, r *http.Reques
 	metrics.CounterWebHookNotifications.Inc()
 	w.WriteHeader(http.StatusNoContent)
 
+	// Replies of the bot may contain prefixes or keywords, which would trigger commands in a loop
+	if notificationChat.Event.ChatterUserID == s.botTwitchUserID {
+		return
+	}
+
 	command := notificationChat.Event.Message.Text
 	usedPingPrefix := false
 	if strings.HasPrefix(strings.ToLower(command), "@"+strings.ToLower(s.botTwitchUserName)+" ") {
@@ -188,12 +204,31 @@ func (s *server) handleWebHookNotification(w http.ResponseWriter, r *http.Reques
 		usedPingPrefix = true
 	}
 
-	if !strings.HasPrefix(command, s.commandPrefix) {
+	channelID := notificationChat.Event.BroadcasterUserID
+	settings := s.getChannelSettings(r.Context(), channelID)
+	prefix := settings.CommandPrefix
+	if len(prefix) == 0 {
+		prefix = s.commandPrefix
+	}
+
+	if strings.HasPrefix(command, prefix) {
+		command = strings.TrimPrefix(command, prefix)
+	} else if triggered, ok := matchKeywordTrigger(settings.KeywordTriggers, command); ok && !usedPingPrefix {
+		command = triggered
+	} else {
 		return
 	}
 
+	// In the bot's chat, built-in commands configure the sender's channel and reply in its language
+	language := settings.Language
+	if channelID == s.botTwitchUserID {
+		language = s.getChannelSettings(r.Context(), notificationChat.Event.ChatterUserID).Language
+	}
+
 	isMod := false
 	isBroadcaster := false
+	isSubscriber := false
+	isVIP := false
 
 	for _, badge := range notificationChat.Event.Badges {
 		if badge.SetID == "moderator" {
@@ -205,14 +240,24 @@ func (s *server) handleWebHookNotification(w http.ResponseWriter, r *http.Reques
 		if badge.SetID == "broadcaster" {
 			isBroadcaster = true
 		}
+		if badge.SetID == "subscriber" || badge.SetID == "founder" {
+			isSubscriber = true
+		}
+		if badge.SetID == "vip" {
+			isVIP = true
+		}
 	}
 
 	ipc := bot.IncomingPossibleCommand{
-		Command:        strings.TrimPrefix(command, s.commandPrefix),
+		Command:        command,
+		Prefix:         prefix,
+		Language:       language,
 		IsModerator:    isMod,
 		IsBroadcaster:  isBroadcaster,
+		IsSubscriber:   isSubscriber,
+		IsVIP:          isVIP,
 		IsAdmin:        slices.Contains(s.adminsUserIDs, notificationChat.Event.ChatterUserID),
-		ChannelID:      notificationChat.Event.BroadcasterUserID,
+		ChannelID:      channelID,
 		ChannelLogin:   notificationChat.Event.BroadcasterUserLogin,
 		SenderID:       notificationChat.Event.ChatterUserID,
 		SenderLogin:    notificationChat.Event.ChatterUserLogin,
@@ -223,7 +268,36 @@ func (s *server) handleWebHookNotification(w http.ResponseWriter, r *http.Reques
 	botContext := NewBotContext(r.Context())
 
 	go s.bot.ExecutePossibleCommand(botContext, &ipc)
-	_ = s.cache.AddCachedEventSubMsg(botContext, messageID)
+}
+
+type webHookNotificationStream struct {
+	Event struct {
+		BroadcasterUserID string    `json:"broadcaster_user_id"`
+		StartedAt         time.Time `json:"started_at"`
+	} `json:"event"`
+}
+
+func (s *server) handleWebHookStreamNotification(w http.ResponseWriter, r *http.Request, subscriptionType string, messageID string, bodyData []byte) {
+	notificationStream := webHookNotificationStream{}
+
+	err := json.Unmarshal(bodyData, &notificationStream)
+	if err != nil {
+		log.Ctx(r.Context()).Warn().Err(err).Msg("could not parse webhook stream notification")
+		_ = s.cache.ReleaseCachedEventSubMsg(r.Context(), messageID)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	metrics.CounterWebHookNotifications.Inc()
+	w.WriteHeader(http.StatusNoContent)
+
+	botContext := NewBotContext(r.Context())
+
+	if subscriptionType == twitch.EventSubTypeStreamOnline {
+		go s.bot.StartStreamSession(botContext, notificationStream.Event.BroadcasterUserID, notificationStream.Event.StartedAt)
+	} else {
+		go s.bot.EndStreamSession(botContext, notificationStream.Event.BroadcasterUserID)
+	}
 }
 
 func NewBotContext(parentContext context.Context) context.Context {
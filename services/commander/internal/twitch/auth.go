@@ -101,6 +101,58 @@ func (api *api) getAppToken(ctx context.Context) (*string, error) {
 	return &res.AccessToken, nil
 }
 
+// SetBotUserToken stores the user token of the bot account, which is needed for requests that can not be made with
+// the app token, like whispers. The refresh token is persisted in the main db, so losing the cache does not require
+// the bot account to be authorized again.
+func (api *api) SetBotUserToken(ctx context.Context, token *TokenResponse) error {
+	err := api.mainDB.UpsertBotUserToken(ctx, api.botUserID, token.RefreshToken)
+	if err != nil {
+		return err
+	}
+
+	return api.cache.SetCachedBotUserToken(ctx, db.CachedBotUserToken{
+		AccessToken:  token.AccessToken,
+		Expiry:       time.Now().Add(time.Second * time.Duration(token.ExpiresIn)),
+		RefreshToken: token.RefreshToken,
+	})
+}
+
+func (api *api) getBotUserToken(ctx context.Context) (*string, error) {
+	botToken, cacheHit, err := api.cache.GetCachedBotUserToken(ctx)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Error reading bot user token from cache")
+	}
+
+	if err == nil && cacheHit && len(botToken.AccessToken) != 0 && botToken.Expiry.After(time.Now()) {
+		return &botToken.AccessToken, nil
+	}
+
+	refreshToken, found, err := api.mainDB.FindBotUserRefreshToken(ctx, api.botUserID)
+	if err != nil {
+		return nil, err
+	}
+	// Tokens stored before they were persisted are only in the cache, refreshing them below moves them to the main db
+	if !found && cacheHit {
+		refreshToken = botToken.RefreshToken
+	}
+	if len(refreshToken) == 0 {
+		return nil, ErrBotUserNotAuthenticated
+	}
+
+	log.Ctx(ctx).Info().Msg("Refreshing Twitch bot user token")
+	res, err := api.GetTokenWithRefreshToken(ctx, refreshToken)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Could not refresh Twitch bot user token!")
+		return nil, err
+	}
+
+	err = api.SetBotUserToken(ctx, res)
+	if err != nil {
+		log.Ctx(ctx).Error().Err(err).Msg("Bot user token could not be stored.")
+	}
+	return &res.AccessToken, nil
+}
+
 func (api *api) doTokenRequest(ctx context.Context, params url.Values) (*TokenResponse, error) {
 	req, err := http.NewRequest("POST", twitchTokenURL, bytes.NewBufferString(params.Encode()))
 	if err != nil {
@@ -11,9 +11,13 @@ import (
 )
 
 const (
-	twitchEventSubURL          = "https://api.twitch.tv/helix/eventsub/subscriptions"
-	EventSubTypeChatMessage    = "channel.chat.message"
-	EventSubVersionChatMessage = "1"
+	twitchEventSubURL            = "https://api.twitch.tv/helix/eventsub/subscriptions"
+	EventSubTypeChatMessage      = "channel.chat.message"
+	EventSubVersionChatMessage   = "1"
+	EventSubTypeStreamOnline     = "stream.online"
+	EventSubVersionStreamOnline  = "1"
+	EventSubTypeStreamOffline    = "stream.offline"
+	EventSubVersionStreamOffline = "1"
 )
 
 type CreateEventSubSubscriptionRequest struct {
@@ -31,6 +35,9 @@ type createEventSubSubscriptionResponse struct {
 		ID string `json:"id"`
 	} `json:"data"`
 }
+type BroadcasterCondition struct {
+	BroadcasterUserId string `json:"broadcaster_user_id"`
+}
 type BroadcasterAndUserCondition struct {
 	BroadcasterUserId string `json:"broadcaster_user_id"`
 	UserId            string `json:"user_id"`
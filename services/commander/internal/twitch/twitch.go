@@ -20,6 +20,8 @@ type API interface {
 	BotUserCondition(broadcasterID string) BroadcasterAndUserCondition
 	EventSubTransport(ctx context.Context) (*EventSubTransportReq, error)
 	SendChatMessage(ctx context.Context, broadcasterID string, message string, replyMessageID *string) error
+	SendWhisper(ctx context.Context, toUserID string, message string) error
+	SetBotUserToken(ctx context.Context, token *TokenResponse) error
 	CheckTransport(ctx context.Context) error
 }
 
@@ -31,11 +33,12 @@ type api struct {
 	webHookURL    string
 	webHookSecret string
 	botConduitID  string
+	mainDB        db.MainDB
 	cache         db.CacheDB
 	httpClient    *http.Client
 }
 
-func NewAPI(cfg *config.CommanderConfig, cache db.CacheDB) API {
+func NewAPI(cfg *config.CommanderConfig, mainDB db.MainDB, cache db.CacheDB) API {
 	httpClient := &http.Client{
 		Transport: &util.LoggingRoundTripper{},
 	}
@@ -48,6 +51,7 @@ func NewAPI(cfg *config.CommanderConfig, cache db.CacheDB) API {
 		webHookURL:    cfg.BaseURL + "/webhooks/twitch",
 		webHookSecret: cfg.Twitch.WebHookSecret,
 		botConduitID:  "",
+		mainDB:        mainDB,
 		cache:         cache,
 		httpClient:    httpClient,
 	}
@@ -0,0 +1,65 @@
+package twitch
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+)
+
+const twitchWhisperURL = "https://api.twitch.tv/helix/whispers"
+
+var (
+	ErrWhisperFailed = errors.New("whisper request failed with non-204 status code")
+)
+
+type whisperSendRequest struct {
+	Message string `json:"message"`
+}
+
+func (api *api) SendWhisper(ctx context.Context, toUserID string, message string) error {
+	botToken, err := api.getBotUserToken(ctx)
+	if err != nil {
+		return err
+	}
+
+	bodyData, err := json.Marshal(whisperSendRequest{
+		Message: message,
+	})
+	if err != nil {
+		return err
+	}
+
+	whisperURL, _ := url.Parse(twitchWhisperURL)
+	params := url.Values{}
+	params.Add("from_user_id", api.botUserID)
+	params.Add("to_user_id", toUserID)
+	whisperURL.RawQuery = params.Encode()
+
+	req, err := http.NewRequest("POST", whisperURL.String(), bytes.NewReader(bodyData))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Client-Id", api.clientID)
+	req.Header.Set("Authorization", "Bearer "+*botToken)
+	req.Header.Set("Content-Type", "application/json")
+	req = req.WithContext(ctx)
+
+	res, err := api.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized {
+		return ErrBotUserNotAuthenticated
+	}
+	if res.StatusCode != http.StatusNoContent {
+		return ErrWhisperFailed
+	}
+
+	return nil
+}
@@ -0,0 +1,26 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// APIKeyPrefix distinguishes API keys from Twitch user access tokens
+const APIKeyPrefix = "rrb_"
+
+// NewAPIKey generates a random API key. Unlike RandomAlphanumericalString it uses a cryptographically secure source.
+func NewAPIKey() string {
+	return APIKeyPrefix + rand.Text()
+}
+
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// HashAPIKey returns the hash that is stored in place of the API key
+func HashAPIKey(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,2 @@
+alter table bot_commands
+    add column if not exists command_type text not null default 'rank';
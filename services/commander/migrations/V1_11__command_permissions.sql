@@ -0,0 +1,2 @@
+alter table bot_commands
+    add column if not exists permission_level text not null default 'everyone';
@@ -0,0 +1,3 @@
+alter table bot_commands
+    add column if not exists user_cooldown_seconds int not null default 0,
+    add column if not exists cooldown_feedback text not null default 'silent';
@@ -0,0 +1,9 @@
+create table if not exists bot_command_aliases
+(
+    twitch_user_id varchar(36) not null,
+    alias_name     varchar(64) not null,
+    command_name   varchar(64) not null,
+    primary key (twitch_user_id, alias_name),
+    foreign key (twitch_user_id, command_name) references bot_commands (twitch_user_id, command_name)
+        on update cascade on delete cascade
+);
@@ -0,0 +1,4 @@
+alter table bot_commands
+    add column if not exists format_version int not null default 1;
+
+create index if not exists rank_history_observed_at_idx on rank_history (observed_at);
@@ -0,0 +1,7 @@
+create table if not exists rank_emotes
+(
+    twitch_user_id varchar(36) not null,
+    rank           int         not null,
+    emote          text        not null,
+    primary key (twitch_user_id, rank)
+);
@@ -0,0 +1,2 @@
+alter table bot_users
+    add column if not exists language text not null default 'en';
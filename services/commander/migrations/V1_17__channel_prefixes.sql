@@ -0,0 +1,12 @@
+alter table bot_users
+    add column if not exists command_prefix text not null default '';
+
+create table if not exists keyword_triggers
+(
+    twitch_user_id varchar(36)  not null,
+    keyword        varchar(100) not null,
+    command_name   varchar(64)  not null,
+    primary key (twitch_user_id, keyword),
+    foreign key (twitch_user_id, command_name) references bot_commands (twitch_user_id, command_name)
+        on update cascade on delete cascade
+);
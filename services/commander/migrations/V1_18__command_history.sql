@@ -0,0 +1,15 @@
+create table if not exists bot_command_history
+(
+    twitch_user_id   varchar(36) not null,
+    command_name     varchar(64) not null,
+    version          int         not null,
+    change_type      text        not null,
+    changed_by_id    varchar(36) not null,
+    changed_by_login text        not null,
+    changed_at       timestamptz not null,
+    previous_command jsonb,
+    command          jsonb,
+    undone_by_login  text,
+    undone_at        timestamptz,
+    primary key (twitch_user_id, command_name, version)
+);
@@ -0,0 +1,16 @@
+alter table bot_commands
+    add column if not exists enabled boolean not null default true;
+
+alter table bot_users
+    add column if not exists commands_paused boolean not null default false;
+
+-- Snapshots recorded before commands could be disabled restore as enabled commands
+update bot_command_history
+set previous_command = jsonb_set(previous_command, '{Enabled}', 'true')
+where previous_command is not null
+  and not previous_command ? 'Enabled';
+
+update bot_command_history
+set command = jsonb_set(command, '{Enabled}', 'true')
+where command is not null
+  and not command ? 'Enabled';
@@ -0,0 +1,12 @@
+create table if not exists api_keys
+(
+    twitch_user_id   varchar(36) not null,
+    key_hash         text        not null,
+    created_by_id    varchar(36) not null,
+    created_by_login text        not null,
+    created_at       timestamptz not null,
+    primary key (twitch_user_id)
+);
+
+create unique index api_keys_key_hash_idx
+    on api_keys (key_hash);
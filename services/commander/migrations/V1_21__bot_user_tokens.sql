@@ -0,0 +1,7 @@
+create table if not exists bot_user_tokens
+(
+    twitch_user_id varchar(36) not null,
+    refresh_token  text        not null,
+    updated_at     timestamptz not null,
+    primary key (twitch_user_id)
+);
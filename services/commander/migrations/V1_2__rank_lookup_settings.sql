@@ -0,0 +1,3 @@
+alter table bot_users
+    add column if not exists lookup_enabled boolean not null default false,
+    add column if not exists lookup_format  text    not null default '';
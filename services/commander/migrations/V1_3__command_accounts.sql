@@ -0,0 +1,20 @@
+create table if not exists bot_command_accounts
+(
+    twitch_user_id varchar(36) not null,
+    command_name   varchar(64) not null,
+    account_index  int         not null,
+    rl_platform    text        not null,
+    rl_username    text        not null,
+    primary key (twitch_user_id, command_name, account_index),
+    foreign key (twitch_user_id, command_name) references bot_commands (twitch_user_id, command_name)
+        on update cascade on delete cascade
+);
+
+insert into bot_command_accounts
+    (twitch_user_id, command_name, account_index, rl_platform, rl_username)
+select twitch_user_id, command_name, 1, rl_platform, rl_username
+from bot_commands;
+
+alter table bot_commands
+    drop column rl_platform,
+    drop column rl_username;
@@ -0,0 +1,21 @@
+create table if not exists stream_sessions
+(
+    twitch_user_id varchar(36) not null,
+    started_at     timestamptz not null,
+    primary key (twitch_user_id)
+);
+
+create table if not exists stream_session_ranks
+(
+    twitch_user_id varchar(36) not null,
+    rl_platform    text        not null,
+    rl_username    text        not null,
+    playlist       int         not null,
+    start_mmr      int         not null,
+    last_mmr       int         not null,
+    wins           int         not null,
+    losses         int         not null,
+    primary key (twitch_user_id, rl_platform, rl_username, playlist),
+    foreign key (twitch_user_id) references stream_sessions (twitch_user_id)
+        on delete cascade
+);
@@ -0,0 +1,11 @@
+create table if not exists rank_history
+(
+    rl_platform text        not null,
+    rl_username text        not null,
+    playlist    int         not null,
+    mmr         int         not null,
+    rank        int         not null,
+    division    int         not null,
+    observed_at timestamptz not null,
+    primary key (rl_platform, rl_username, playlist, observed_at)
+);
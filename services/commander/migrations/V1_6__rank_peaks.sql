@@ -0,0 +1,12 @@
+create table if not exists rank_peaks
+(
+    rl_platform   text        not null,
+    rl_username   text        not null,
+    playlist      int         not null,
+    season        int         not null,
+    peak_mmr      int         not null,
+    peak_rank     int         not null,
+    peak_division int         not null,
+    updated_at    timestamptz not null,
+    primary key (rl_platform, rl_username, playlist, season)
+);
@@ -0,0 +1,8 @@
+create table if not exists viewer_accounts
+(
+    twitch_user_id varchar(36) not null,
+    rl_platform    text        not null,
+    rl_username    text        not null,
+    linked_at      timestamptz not null,
+    primary key (twitch_user_id, rl_platform, rl_username)
+);
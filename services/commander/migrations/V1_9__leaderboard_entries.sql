@@ -0,0 +1,9 @@
+create table if not exists leaderboard_entries
+(
+    channel_id        varchar(36) not null,
+    twitch_user_id    varchar(36) not null,
+    twitch_user_login text        not null,
+    rl_platform       text        not null,
+    rl_username       text        not null,
+    primary key (channel_id, twitch_user_id)
+);